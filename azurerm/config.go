@@ -13,7 +13,7 @@ import (
 	appinsights "github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
-	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2019-04-15/cdn"
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2017-04-18/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-10-01/containerinstance"
@@ -24,7 +24,9 @@ import (
 	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
 	storeAccount "github.com/Azure/azure-sdk-for-go/services/datalake/store/mgmt/2016-11-01/account"
+	"github.com/Azure/azure-sdk-for-go/services/devspaces/mgmt/2019-04-01/devspaces"
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2018-01-01/eventgrid"
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
@@ -32,26 +34,25 @@ import (
 	keyVault "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2016-06-01/logic"
+	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/apimanagement/mgmt/2018-06-01-preview/apimanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
-	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
-	"github.com/Azure/azure-sdk-for-go/services/preview/mariadb/mgmt/2018-06-01-preview/mariadb"
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
-	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/2017-08-01-preview/security"
+	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
-	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-06-01/subscriptions"
@@ -63,7 +64,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
@@ -89,6 +91,10 @@ type ArmClient struct {
 	environment              az.Environment
 	skipProviderRegistration bool
 
+	storageUseAzureAD             bool
+	storageAzureADAuthorizer      autorest.Authorizer
+	storageAllowSharedKeyFallback bool
+
 	StopContext context.Context
 
 	cosmosDBClient documentdb.DatabaseAccountsClient
@@ -178,6 +184,11 @@ type ArmClient struct {
 	// DevSpace
 	devSpaceControllerClient devspaces.ControllersClient
 
+	// Front Door
+	frontDoorsClient         frontdoor.FrontDoorsClient
+	frontDoorsFrontendClient frontdoor.FrontendEndpointsClient
+	frontDoorsPolicyClient   frontdoor.PoliciesClient
+
 	// Databases
 	mariadbDatabasesClient                   mariadb.DatabasesClient
 	mariadbServersClient                     mariadb.ServersClient
@@ -247,22 +258,38 @@ type ArmClient struct {
 	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
+	expressRouteConnectionsClient   network.ExpressRouteCircuitConnectionsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
+	hubVnetConnectionsClient        network.HubVirtualNetworkConnectionsClient
 	ifaceClient                     network.InterfacesClient
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
+	natGatewayClient                network.NatGatewaysClient
 	packetCapturesClient            network.PacketCapturesClient
+	privateDNSZoneGroupClient       network.PrivateDNSZoneGroupsClient
+	privateEndpointClient           network.PrivateEndpointsClient
+	privateLinkServiceClient        network.PrivateLinkServicesClient
 	publicIPClient                  network.PublicIPAddressesClient
+	publicIPPrefixClient            network.PublicIPPrefixesClient
+	routeFiltersClient              network.RouteFiltersClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
 	secRuleClient                   network.SecurityRulesClient
+	serviceEndpointPoliciesClient   network.ServiceEndpointPoliciesClient
+	serviceTagsClient               network.ServiceTagsClient
 	subnetClient                    network.SubnetsClient
 	vnetGatewayConnectionsClient    network.VirtualNetworkGatewayConnectionsClient
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	virtualHubClient                network.VirtualHubsClient
+	virtualWanClient                network.VirtualWansClient
+	vpnConnectionClient             network.VpnConnectionsClient
+	vpnGatewayClient                network.VpnGatewaysClient
+	vpnSiteClient                   network.VpnSitesClient
 	watcherClient                   network.WatchersClient
+	wafPolicyClient                 network.WebApplicationFirewallPoliciesClient
 
 	// Notification Hubs
 	notificationHubsClient       notificationhubs.Client
@@ -270,7 +297,7 @@ type ArmClient struct {
 
 	// Recovery Services
 	recoveryServicesVaultsClient             recoveryservices.VaultsClient
-	recoveryServicesProtectedItemsClient     backup.ProtectedItemsGroupClient
+	recoveryServicesProtectedItemsClient     backup.ProtectedItemsClient
 	recoveryServicesProtectionPoliciesClient backup.ProtectionPoliciesClient
 
 	// Relay
@@ -312,8 +339,10 @@ type ArmClient struct {
 	signalRClient signalr.Client
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageServiceClient    storage.AccountsClient
+	storageUsageClient      storage.UsageClient
+	storagePolicyClient     storageMgmt.ManagementPoliciesClient
+	storageContainersClient storageMgmt.BlobContainersClient
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
@@ -381,7 +410,7 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, storageUseAzureAD bool) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -396,6 +425,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		storageUseAzureAD:        storageUseAzureAD,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -422,6 +452,15 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, err
 	}
 
+	// Storage Endpoints
+	if storageUseAzureAD {
+		storageAuth, err := c.GetAuthorizationToken(oauthConfig, storageAzureADResource)
+		if err != nil {
+			return nil, err
+		}
+		client.storageAzureADAuthorizer = storageAuth
+	}
+
 	// Key Vault Endpoints
 	sender := azure.BuildSender()
 	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
@@ -452,6 +491,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
 	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
 	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
+	client.registerFrontDoorClients(endpoint, c.SubscriptionID, auth)
 	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
 	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
 	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
@@ -816,6 +856,20 @@ func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, aut
 	c.devSpaceControllerClient = controllersClient
 }
 
+func (c *ArmClient) registerFrontDoorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	frontDoorsClient := frontdoor.NewFrontDoorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&frontDoorsClient.Client, auth)
+	c.frontDoorsClient = frontDoorsClient
+
+	frontDoorsFrontendClient := frontdoor.NewFrontendEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&frontDoorsFrontendClient.Client, auth)
+	c.frontDoorsFrontendClient = frontDoorsFrontendClient
+
+	frontDoorsPolicyClient := frontdoor.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&frontDoorsPolicyClient.Client, auth)
+	c.frontDoorsPolicyClient = frontDoorsPolicyClient
+}
+
 func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
@@ -921,10 +975,18 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&expressRouteCircuitsClient.Client, auth)
 	c.expressRouteCircuitClient = expressRouteCircuitsClient
 
+	expressRouteConnectionsClient := network.NewExpressRouteCircuitConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&expressRouteConnectionsClient.Client, auth)
+	c.expressRouteConnectionsClient = expressRouteConnectionsClient
+
 	expressRoutePeeringsClient := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRoutePeeringsClient.Client, auth)
 	c.expressRoutePeeringsClient = expressRoutePeeringsClient
 
+	hubVnetConnectionsClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&hubVnetConnectionsClient.Client, auth)
+	c.hubVnetConnectionsClient = hubVnetConnectionsClient
+
 	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&interfacesClient.Client, auth)
 	c.ifaceClient = interfacesClient
@@ -937,6 +999,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&localNetworkGatewaysClient.Client, auth)
 	c.localNetConnClient = localNetworkGatewaysClient
 
+	natGatewayClient := network.NewNatGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&natGatewayClient.Client, auth)
+	c.natGatewayClient = natGatewayClient
+
 	gatewaysClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewaysClient.Client, auth)
 	c.vnetGatewayClient = gatewaysClient
@@ -953,6 +1019,18 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&packetCapturesClient.Client, auth)
 	c.packetCapturesClient = packetCapturesClient
 
+	privateDNSZoneGroupClient := network.NewPrivateDNSZoneGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateDNSZoneGroupClient.Client, auth)
+	c.privateDNSZoneGroupClient = privateDNSZoneGroupClient
+
+	privateEndpointClient := network.NewPrivateEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateEndpointClient.Client, auth)
+	c.privateEndpointClient = privateEndpointClient
+
+	privateLinkServiceClient := network.NewPrivateLinkServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateLinkServiceClient.Client, auth)
+	c.privateLinkServiceClient = privateLinkServiceClient
+
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&peeringsClient.Client, auth)
 	c.vnetPeeringsClient = peeringsClient
@@ -961,6 +1039,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	publicIPPrefixClient := network.NewPublicIPPrefixesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&publicIPPrefixClient.Client, auth)
+	c.publicIPPrefixClient = publicIPPrefixClient
+
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFiltersClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -977,6 +1063,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&securityRulesClient.Client, auth)
 	c.secRuleClient = securityRulesClient
 
+	serviceEndpointPoliciesClient := network.NewServiceEndpointPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&serviceEndpointPoliciesClient.Client, auth)
+	c.serviceEndpointPoliciesClient = serviceEndpointPoliciesClient
+
+	serviceTagsClient := network.NewServiceTagsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&serviceTagsClient.Client, auth)
+	c.serviceTagsClient = serviceTagsClient
+
 	subnetsClient := network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subnetsClient.Client, auth)
 	c.subnetClient = subnetsClient
@@ -985,9 +1079,33 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.virtualHubClient = virtualHubsClient
+
+	virtualWansClient := network.NewVirtualWansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.virtualWanClient = virtualWansClient
+
+	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnConnectionsClient.Client, auth)
+	c.vpnConnectionClient = vpnConnectionsClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.vpnGatewayClient = vpnGatewaysClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSiteClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
+
+	wafPolicyClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&wafPolicyClient.Client, auth)
+	c.wafPolicyClient = wafPolicyClient
 }
 
 func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1019,7 +1137,7 @@ func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId stri
 	c.configureClient(&vaultsClient.Client, auth)
 	c.recoveryServicesVaultsClient = vaultsClient
 
-	protectedItemsClient := backup.NewProtectedItemsGroupClientWithBaseURI(endpoint, subscriptionId)
+	protectedItemsClient := backup.NewProtectedItemsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&protectedItemsClient.Client, auth)
 	c.recoveryServicesProtectedItemsClient = protectedItemsClient
 
@@ -1153,6 +1271,14 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
+
+	policiesClient := storageMgmt.NewManagementPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&policiesClient.Client, auth)
+	c.storagePolicyClient = policiesClient
+
+	containersClient := storageMgmt.NewBlobContainersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&containersClient.Client, auth)
+	c.storageContainersClient = containersClient
 }
 
 func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1253,9 +1379,51 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	return key, true, nil
 }
 
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+func (c *ArmClient) storageAccountExists(ctx context.Context, resourceGroupName, storageAccountName string) (bool, error) {
+	account, err := c.storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return false, nil
+		}
+
+		return true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
+	}
+
+	return true, nil
+}
+
+// getStorageClientForStorageAccount returns a data-plane client for the specified Storage Account. When
+// `storage_use_azuread` is enabled requests are authorized using Azure Active Directory for services which support it -
+// the File & Table services don't, so these only fall back to the Account Key when `storage_allow_shared_key_fallback`
+// is also enabled. Otherwise the Account Key is used.
+func (c *ArmClient) getStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string, supportsAzureAD bool) (*mainStorage.Client, bool, error) {
+	if c.storageUseAzureAD {
+		if !supportsAzureAD && !c.storageAllowSharedKeyFallback {
+			return nil, false, fmt.Errorf("Error creating storage client for storage storeAccount %q: this service doesn't support Azure Active Directory authentication. Set `storage_allow_shared_key_fallback` to `true` to use the Storage Account's Access Key for it when `storage_use_azuread` is enabled", storageAccountName)
+		}
+
+		if supportsAzureAD {
+			accountExists, err := c.storageAccountExists(ctx, resourceGroupName, storageAccountName)
+			if err != nil || !accountExists {
+				return nil, accountExists, err
+			}
+
+			storageClient, err := newStorageAzureADClient(storageAccountName, c.environment.StorageEndpointSuffix, c.storageAzureADAuthorizer)
+			if err != nil {
+				return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+			}
+
+			return storageClient, true, nil
+		}
+
+		log.Printf("[DEBUG] Falling back to Shared Key authentication for storage storeAccount %q since this service doesn't support Azure Active Directory and `storage_allow_shared_key_fallback` is enabled", storageAccountName)
+	}
+
 	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
+		if c.storageUseAzureAD {
+			return nil, accountExists, fmt.Errorf("%s\n\nThis service doesn't support Azure Active Directory authentication, so Shared Key access must be permitted on the storage storeAccount", err)
+		}
 		return nil, accountExists, err
 	}
 	if !accountExists {
@@ -1268,42 +1436,69 @@ func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, r
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
 	}
 
+	return &storageClient, true, nil
+}
+
+func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, true)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
+
 	blobClient := storageClient.GetBlobService()
 	return &blobClient, true, nil
 }
 
 func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, false)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
+
+	fileClient := storageClient.GetFileService()
+	return &fileClient, true, nil
+}
+
+// getFileShareACLClientForStorageAccount returns a client for the Stored Access Policies of a File Share, which (as for
+// the other File Service operations) only supports Shared Key authentication
+func (c *ArmClient) getFileShareACLClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageShareACLClient, bool, error) {
+	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
+	authorizer, err := newStorageSharedKeyAuthorizer(storageAccountName, key)
 	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+		return nil, true, err
 	}
 
-	fileClient := storageClient.GetFileService()
-	return &fileClient, true, nil
+	client := newStorageShareACLClient(storageAccountName, c.environment.StorageEndpointSuffix)
+	c.configureClient(&client.Client, authorizer)
+	return &client, true, nil
 }
 
-func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
+// getFileShareAccessTierClientForStorageAccount returns a client for the Access Tier of a File Share, which (as for the
+// other File Service operations) only supports Shared Key authentication
+func (c *ArmClient) getFileShareAccessTierClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageShareAccessTierClient, bool, error) {
 	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
+	authorizer, err := newStorageSharedKeyAuthorizer(storageAccountName, key)
 	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+		return nil, true, err
+	}
+
+	client := newStorageShareAccessTierClient(storageAccountName, c.environment.StorageEndpointSuffix)
+	c.configureClient(&client.Client, authorizer)
+	return &client, true, nil
+}
+
+func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, false)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
 	}
 
 	tableClient := storageClient.GetTableService()
@@ -1311,20 +1506,57 @@ func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context,
 }
 
 func (c *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName, true)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
+
+	queueClient := storageClient.GetQueueService()
+	return &queueClient, true, nil
+}
+
+// getBlobAuthorizerForStorageAccount returns an Authorizer for the Blob data-plane clients which aren't part of the Storage SDK
+func (c *ArmClient) getBlobAuthorizerForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (autorest.Authorizer, bool, error) {
+	if c.storageUseAzureAD {
+		accountExists, err := c.storageAccountExists(ctx, resourceGroupName, storageAccountName)
+		if err != nil || !accountExists {
+			return nil, accountExists, err
+		}
+
+		return c.storageAzureADAuthorizer, true, nil
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
+	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
+
+	authorizer, err := newStorageSharedKeyAuthorizer(storageAccountName, key)
 	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+		return nil, true, err
 	}
 
-	queueClient := storageClient.GetQueueService()
-	return &queueClient, true, nil
+	return authorizer, true, nil
+}
+
+func (c *ArmClient) getBlobServicePropertiesClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageBlobServicePropertiesClient, bool, error) {
+	authorizer, accountExists, err := c.getBlobAuthorizerForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
+
+	client := newStorageBlobServicePropertiesClient(storageAccountName, c.environment.StorageEndpointSuffix)
+	c.configureClient(&client.Client, authorizer)
+	return &client, true, nil
+}
+
+func (c *ArmClient) getBlobAccessTierClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageBlobAccessTierClient, bool, error) {
+	authorizer, accountExists, err := c.getBlobAuthorizerForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
+
+	client := newStorageBlobAccessTierClient(storageAccountName, c.environment.StorageEndpointSuffix)
+	c.configureClient(&client.Client, authorizer)
+	return &client, true, nil
 }
@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmNetworkServiceTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkServiceTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    azureRMNormalizeLocation,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"change_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cloud": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"change_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkServiceTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceTagsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	service := d.Get("service").(string)
+	region := azureRMNormalizeLocation(d.Get("region").(string))
+
+	resp, err := client.List(ctx, location)
+	if err != nil {
+		return fmt.Errorf("Error listing Service Tags in %q: %+v", location, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error listing Service Tags in %q: ID was nil", location)
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("location", location)
+	d.Set("change_number", resp.ChangeNumber)
+	d.Set("cloud", resp.Cloud)
+
+	if err := d.Set("service_tags", flattenNetworkServiceTags(resp.Values, service, region)); err != nil {
+		return fmt.Errorf("Error setting `service_tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkServiceTags(input *[]network.ServiceTagInformation, service string, region string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, tag := range *input {
+		output := make(map[string]interface{})
+
+		if tag.ID != nil {
+			output["id"] = *tag.ID
+		}
+		if tag.Name != nil {
+			output["name"] = *tag.Name
+		}
+
+		tagRegion := ""
+		tagService := ""
+		addressPrefixes := make([]interface{}, 0)
+		if props := tag.Properties; props != nil {
+			if props.Region != nil {
+				tagRegion = *props.Region
+			}
+			if props.SystemService != nil {
+				tagService = *props.SystemService
+			}
+			if props.ChangeNumber != nil {
+				output["change_number"] = *props.ChangeNumber
+			}
+			if props.AddressPrefixes != nil {
+				for _, v := range *props.AddressPrefixes {
+					addressPrefixes = append(addressPrefixes, v)
+				}
+			}
+		}
+
+		if service != "" && !strings.EqualFold(service, tagService) {
+			continue
+		}
+		if region != "" && !strings.EqualFold(region, azureRMNormalizeLocation(tagRegion)) {
+			continue
+		}
+
+		output["region"] = tagRegion
+		output["system_service"] = tagService
+		output["address_prefixes"] = addressPrefixes
+
+		results = append(results, output)
+	}
+
+	return results
+}
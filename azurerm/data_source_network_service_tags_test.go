@@ -0,0 +1,134 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMNetworkServiceTags_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_service_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkServiceTags_basic(testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "change_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cloud"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_tags.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMNetworkServiceTags_filtered(t *testing.T) {
+	dataSourceName := "data.azurerm_network_service_tags.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkServiceTags_filtered(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_tags.0.system_service", "AzureStorage"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_tags.0.region", azureRMNormalizeLocation(location)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_tags.0.address_prefixes.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenNetworkServiceTags(t *testing.T) {
+	tag := func(name, region, service string) network.ServiceTagInformation {
+		return network.ServiceTagInformation{
+			Name: utils.String(name),
+			ID:   utils.String(name),
+			Properties: &network.ServiceTagInformationPropertiesFormat{
+				ChangeNumber:    utils.String("1"),
+				Region:          utils.String(region),
+				SystemService:   utils.String(service),
+				AddressPrefixes: &[]string{"10.0.0.0/24"},
+			},
+		}
+	}
+	input := &[]network.ServiceTagInformation{
+		tag("Storage", "", "AzureStorage"),
+		tag("Storage.WestEurope", "westeurope", "AzureStorage"),
+		tag("Sql.WestEurope", "westeurope", "AzureSQL"),
+		tag("Sql.EastUS", "eastus", "AzureSQL"),
+	}
+
+	cases := []struct {
+		Service  string
+		Region   string
+		Expected []string
+	}{
+		{
+			Expected: []string{"Storage", "Storage.WestEurope", "Sql.WestEurope", "Sql.EastUS"},
+		},
+		{
+			Service:  "azurestorage",
+			Expected: []string{"Storage", "Storage.WestEurope"},
+		},
+		{
+			Region:   "westeurope",
+			Expected: []string{"Storage.WestEurope", "Sql.WestEurope"},
+		},
+		{
+			Service:  "AzureSQL",
+			Region:   "eastus",
+			Expected: []string{"Sql.EastUS"},
+		},
+		{
+			Service:  "AzureCosmosDB",
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range cases {
+		output := flattenNetworkServiceTags(input, v.Service, v.Region)
+		if len(output) != len(v.Expected) {
+			t.Fatalf("Expected %d Service Tags for %q / %q but got %d", len(v.Expected), v.Service, v.Region, len(output))
+		}
+
+		for i, name := range v.Expected {
+			if actual := output[i].(map[string]interface{})["name"].(string); actual != name {
+				t.Fatalf("Expected Service Tag %d for %q / %q to be %q but got %q", i, v.Service, v.Region, name, actual)
+			}
+		}
+	}
+
+	if output := flattenNetworkServiceTags(nil, "", ""); len(output) != 0 {
+		t.Fatalf("Expected no Service Tags for a nil input but got %d", len(output))
+	}
+}
+
+func testAccDataSourceAzureRMNetworkServiceTags_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_network_service_tags" "test" {
+  location = "%s"
+}
+`, location)
+}
+
+func testAccDataSourceAzureRMNetworkServiceTags_filtered(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_network_service_tags" "test" {
+  location = "%s"
+  service  = "AzureStorage"
+  region   = "%s"
+}
+`, location, location)
+}
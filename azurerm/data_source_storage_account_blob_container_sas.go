@@ -0,0 +1,339 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const storageServiceSasSignedVersion = "2018-03-28"
+
+// storageBlobServiceSas contains the fields which make up a Service SAS for a Blob Container or Blob
+// https://docs.microsoft.com/en-us/rest/api/storageservices/constructing-a-service-sas
+type storageBlobServiceSas struct {
+	AccountName   string
+	AccountKey    string
+	ContainerName string
+	// when BlobName is set the SAS is scoped to the Blob, otherwise to the Container
+	BlobName           string
+	Permissions        string
+	Start              string
+	Expiry             string
+	Identifier         string
+	IPAddress          string
+	Protocol           string
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	ContentType        string
+}
+
+// This is a SERVICE SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/constructing-a-service-sas
+// for a Blob Container (or a Blob within it)
+func dataSourceArmStorageAccountBlobContainerSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageAccountBlobContainerSasRead,
+
+		Schema: map[string]*schema.Schema{
+			"connection_string": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageContainerName,
+			},
+
+			"blob_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"https_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStorageSasIPAddress,
+			},
+
+			// the ID of a Stored Access Policy on the Container, which allows the SAS to be revoked
+			"access_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			// Always in UTC and must be ISO-8601 format - optional when defined in the Stored Access Policy
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Always in UTC and must be ISO-8601 format - optional when defined in the Stored Access Policy
+			"expiry": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// optional when defined in the Stored Access Policy
+			"permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"add": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"create": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"write": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"delete": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"list": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"sas": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmStorageAccountBlobContainerSasRead(d *schema.ResourceData, _ interface{}) error {
+	connString := d.Get("connection_string").(string)
+
+	kvp, err := storage.ParseAccountSASConnectionString(connString)
+	if err != nil {
+		return err
+	}
+
+	input := storageBlobServiceSas{
+		AccountName:        kvp[connStringAccountNameKey],
+		AccountKey:         kvp[connStringAccountKeyKey],
+		ContainerName:      d.Get("container_name").(string),
+		BlobName:           d.Get("blob_name").(string),
+		Start:              d.Get("start").(string),
+		Expiry:             d.Get("expiry").(string),
+		Identifier:         d.Get("access_policy_id").(string),
+		IPAddress:          d.Get("ip_address").(string),
+		Protocol:           "https,http",
+		CacheControl:       d.Get("cache_control").(string),
+		ContentDisposition: d.Get("content_disposition").(string),
+		ContentEncoding:    d.Get("content_encoding").(string),
+		ContentLanguage:    d.Get("content_language").(string),
+		ContentType:        d.Get("content_type").(string),
+	}
+
+	if d.Get("https_only").(bool) {
+		input.Protocol = "https"
+	}
+
+	if permissionsRaw := d.Get("permissions").([]interface{}); len(permissionsRaw) > 0 && permissionsRaw[0] != nil {
+		permissions := permissionsRaw[0].(map[string]interface{})
+		input.Permissions = buildBlobContainerSasPermissionsString(permissions)
+
+		if input.BlobName != "" && permissions["list"].(bool) {
+			return fmt.Errorf("`list` permissions can only be granted when the SAS is for a Container (`blob_name` is not set)")
+		}
+	}
+
+	// anything not specified in the SAS has to come from the Stored Access Policy
+	if input.Identifier == "" {
+		if input.Start == "" || input.Expiry == "" || input.Permissions == "" {
+			return fmt.Errorf("`start`, `expiry` and `permissions` must be specified when `access_policy_id` is not set")
+		}
+	}
+
+	sasToken, err := computeStorageBlobServiceSasToken(input)
+	if err != nil {
+		return err
+	}
+
+	d.Set("sas", sasToken)
+	tokenHash := sha256.Sum256([]byte(sasToken))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+
+	return nil
+}
+
+// computeStorageBlobServiceSasToken signs the Service SAS using the Storage Account Key, returning the SAS Token
+// including the leading `?` so that it can be appended to the URL of the Container (or Blob)
+func computeStorageBlobServiceSasToken(input storageBlobServiceSas) (string, error) {
+	signedResource := "c"
+	canonicalizedResource := fmt.Sprintf("/blob/%s/%s", input.AccountName, input.ContainerName)
+	if input.BlobName != "" {
+		signedResource = "b"
+		canonicalizedResource = fmt.Sprintf("%s/%s", canonicalizedResource, input.BlobName)
+	}
+
+	// UTF-8 by default...
+	stringToSign := strings.Join([]string{
+		input.Permissions,
+		input.Start,
+		input.Expiry,
+		canonicalizedResource,
+		input.Identifier,
+		input.IPAddress,
+		input.Protocol,
+		storageServiceSasSignedVersion,
+		input.CacheControl,
+		input.ContentDisposition,
+		input.ContentEncoding,
+		input.ContentLanguage,
+		input.ContentType,
+	}, "\n")
+
+	binaryKey, err := base64.StdEncoding.DecodeString(input.AccountKey)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the Storage Account Key: %+v", err)
+	}
+	hasher := hmac.New(sha256.New, binaryKey)
+	hasher.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+
+	// the order of these parameters doesn't matter, however url.Values sorts them which keeps the token stable
+	values := url.Values{}
+	values.Set("sv", storageServiceSasSignedVersion)
+	values.Set("sr", signedResource)
+	values.Set("spr", input.Protocol)
+	values.Set("sig", signature)
+
+	optional := map[string]string{
+		"sp":   input.Permissions,
+		"st":   input.Start,
+		"se":   input.Expiry,
+		"si":   input.Identifier,
+		"sip":  input.IPAddress,
+		"rscc": input.CacheControl,
+		"rscd": input.ContentDisposition,
+		"rsce": input.ContentEncoding,
+		"rscl": input.ContentLanguage,
+		"rsct": input.ContentType,
+	}
+	for key, value := range optional {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+
+	return "?" + values.Encode(), nil
+}
+
+func buildBlobContainerSasPermissionsString(perms map[string]interface{}) string {
+	retVal := ""
+
+	if val, pres := perms["read"].(bool); pres && val {
+		retVal += "r"
+	}
+
+	if val, pres := perms["add"].(bool); pres && val {
+		retVal += "a"
+	}
+
+	if val, pres := perms["create"].(bool); pres && val {
+		retVal += "c"
+	}
+
+	if val, pres := perms["write"].(bool); pres && val {
+		retVal += "w"
+	}
+
+	if val, pres := perms["delete"].(bool); pres && val {
+		retVal += "d"
+	}
+
+	if val, pres := perms["list"].(bool); pres && val {
+		retVal += "l"
+	}
+
+	return retVal
+}
+
+// validateStorageSasIPAddress validates either a single IPv4 Address or a range of IPv4 Addresses (e.g. `168.1.5.60-168.1.5.70`)
+func validateStorageSasIPAddress(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	addresses := strings.Split(value, "-")
+	if len(addresses) > 2 {
+		errors = append(errors, fmt.Errorf("%q must be a single IPv4 Address or a range of IPv4 Addresses separated by a `-`: %q", k, value))
+		return warnings, errors
+	}
+
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			errors = append(errors, fmt.Errorf("%q must be a single IPv4 Address or a range of IPv4 Addresses separated by a `-`: %q", k, value))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,386 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceArmStorageAccountBlobContainerSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_blob_container_sas.test"
+	rInt := tf.AccRandTimeInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageAccountBlobContainerSas_basic(rInt, rString, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "https_only", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "expiry", endDate),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_address", "168.1.5.65"),
+					resource.TestCheckResourceAttr(dataSourceName, "cache_control", "max-age=5"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageAccountBlobContainerSas_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestsa-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  https_only        = true
+  ip_address        = "168.1.5.65"
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = true
+    create = false
+    write  = false
+    delete = true
+    list   = true
+  }
+
+  cache_control       = "max-age=5"
+  content_disposition = "inline"
+  content_encoding    = "deflate"
+  content_language    = "en-US"
+  content_type        = "application/json"
+}
+`, rInt, location, rString, startDate, endDate)
+}
+
+func TestDataSourceArmStorageAccountBlobContainerSas_permissionsString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"read": true}, "r"},
+		{map[string]interface{}{"add": true}, "a"},
+		{map[string]interface{}{"create": true}, "c"},
+		{map[string]interface{}{"write": true}, "w"},
+		{map[string]interface{}{"delete": true}, "d"},
+		{map[string]interface{}{"list": true}, "l"},
+		{map[string]interface{}{"read": true, "add": true, "create": true, "write": true, "delete": true, "list": true}, "racwdl"},
+		{map[string]interface{}{"list": true, "read": true, "write": false}, "rl"},
+	}
+
+	for _, test := range testCases {
+		result := buildBlobContainerSasPermissionsString(test.input)
+		if test.expected != result {
+			t.Fatalf("Failed to build permissions string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
+
+func TestValidateStorageSasIPAddress(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "168.1.5.65",
+			ErrCount: 0,
+		},
+		{
+			Value:    "168.1.5.60-168.1.5.70",
+			ErrCount: 0,
+		},
+		{
+			Value:    "168.1.5.60-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "168.1.5.60-168.1.5.70-168.1.5.80",
+			ErrCount: 1,
+		},
+		{
+			Value:    "168.1.5.0/24",
+			ErrCount: 1,
+		},
+		{
+			Value:    "2001:db8::1",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateStorageSasIPAddress(tc.Value, "ip_address")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the IP Address %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+// This connection string was for a real storage account which has been deleted
+// so its safe to include here for reference to understand the format.
+// DefaultEndpointsProtocol=https;AccountName=azurermtestsa0;AccountKey=T0ZQouXBDpWud/PlTRHIJH2+VUK8D+fnedEynb9Mx638IYnsMUe4mv1fFjC7t0NayTfFAQJzPZuV1WHFKOzGdg==;EndpointSuffix=core.windows.net
+func TestComputeStorageBlobServiceSasToken(t *testing.T) {
+	accountKey := "T0ZQouXBDpWud/PlTRHIJH2+VUK8D+fnedEynb9Mx638IYnsMUe4mv1fFjC7t0NayTfFAQJzPZuV1WHFKOzGdg=="
+
+	// the `stringToSign` for each case is written out by hand following the field order for version 2018-03-28
+	// documented at https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas#version-2018-11-09-and-earlier
+	// (signedpermissions, signedstart, signedexpiry, canonicalizedresource, signedidentifier, signedIP, signedProtocol,
+	// signedversion, rscc, rscd, rsce, rscl, rsct) - so the expected signature is derived independently of the code under test
+	testCases := []struct {
+		name          string
+		input         storageBlobServiceSas
+		stringToSign  string
+		knownSasToken string
+	}{
+		{
+			name: "Container",
+			input: storageBlobServiceSas{
+				AccountName:   "azurermtestsa0",
+				AccountKey:    accountKey,
+				ContainerName: "images",
+				Permissions:   "rwl",
+				Start:         "2018-03-20T04:00:00Z",
+				Expiry:        "2020-03-20T04:00:00Z",
+				Protocol:      "https",
+			},
+			stringToSign:  "rwl\n2018-03-20T04:00:00Z\n2020-03-20T04:00:00Z\n/blob/azurermtestsa0/images\n\n\nhttps\n2018-03-28\n\n\n\n\n",
+			knownSasToken: "?se=2020-03-20T04%3A00%3A00Z&sig=xzjbsyPIz3KrGLZrnksGh2r3yanSGX4gs%2B579bh%2Bjjk%3D&sp=rwl&spr=https&sr=c&st=2018-03-20T04%3A00%3A00Z&sv=2018-03-28",
+		},
+		{
+			name: "Blob with Access Policy, IP Range and Headers",
+			input: storageBlobServiceSas{
+				AccountName:        "azurermtestsa0",
+				AccountKey:         accountKey,
+				ContainerName:      "images",
+				BlobName:           "logo.png",
+				Permissions:        "r",
+				Expiry:             "2020-03-20T04:00:00Z",
+				Identifier:         "policy1",
+				IPAddress:          "168.1.5.60-168.1.5.70",
+				Protocol:           "https",
+				CacheControl:       "max-age=5",
+				ContentDisposition: "attachment; filename=\"logo.png\"",
+				ContentEncoding:    "gzip",
+				ContentLanguage:    "en-GB",
+				ContentType:        "image/png",
+			},
+			stringToSign:  "r\n\n2020-03-20T04:00:00Z\n/blob/azurermtestsa0/images/logo.png\npolicy1\n168.1.5.60-168.1.5.70\nhttps\n2018-03-28\nmax-age=5\nattachment; filename=\"logo.png\"\ngzip\nen-GB\nimage/png",
+			knownSasToken: "?rscc=max-age%3D5&rscd=attachment%3B+filename%3D%22logo.png%22&rsce=gzip&rscl=en-GB&rsct=image%2Fpng&se=2020-03-20T04%3A00%3A00Z&si=policy1&sig=uAGmIePq2mP%2Big7IeHfkAMYOWyVt1owgEWG%2BHt0J9pc%3D&sip=168.1.5.60-168.1.5.70&sp=r&spr=https&sr=b&sv=2018-03-28",
+		},
+		{
+			name: "Container using only an Access Policy",
+			input: storageBlobServiceSas{
+				AccountName:   "azurermtestsa0",
+				AccountKey:    accountKey,
+				ContainerName: "images",
+				Identifier:    "policy1",
+				Protocol:      "https,http",
+			},
+			stringToSign:  "\n\n\n/blob/azurermtestsa0/images\npolicy1\n\nhttps,http\n2018-03-28\n\n\n\n\n",
+			knownSasToken: "?si=policy1&sig=MKfNVy%2F9TutP4QddhWyG92PGX4atA1021fOe6xsHBqc%3D&spr=https%2Chttp&sr=c&sv=2018-03-28",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.name)
+
+		computedToken, err := computeStorageBlobServiceSasToken(test.input)
+		if err != nil {
+			t.Fatalf("Error computing the Service SAS: %+v", err)
+		}
+
+		if computedToken != test.knownSasToken {
+			t.Fatalf("Expected the Service SAS %s but got %s", test.knownSasToken, computedToken)
+		}
+
+		binaryKey, _ := base64.StdEncoding.DecodeString(accountKey)
+		hasher := hmac.New(sha256.New, binaryKey)
+		hasher.Write([]byte(test.stringToSign))
+		expectedSignature := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+		if signature := storageSasTokenSignature(t, computedToken); signature != expectedSignature {
+			t.Fatalf("Expected the signature of the documented string-to-sign %q but got %q", expectedSignature, signature)
+		}
+	}
+}
+
+func TestComputeStorageBlobServiceSasToken_matchesSDK(t *testing.T) {
+	accountName := "azurermtestsa0"
+	accountKey := "T0ZQouXBDpWud/PlTRHIJH2+VUK8D+fnedEynb9Mx638IYnsMUe4mv1fFjC7t0NayTfFAQJzPZuV1WHFKOzGdg=="
+	start := time.Date(2018, 3, 20, 4, 0, 0, 0, time.UTC)
+	expiry := time.Date(2020, 3, 20, 4, 0, 0, 0, time.UTC)
+
+	// the legacy Storage SDK signs Service SAS' using the same (2018-03-28) version, however it doesn't emit
+	// the `si` parameter - so only the signatures are compared, which covers the string-to-sign
+	client, err := mainStorage.NewBasicClient(accountName, accountKey)
+	if err != nil {
+		t.Fatalf("Error building the Storage Client: %+v", err)
+	}
+	blobService := client.GetBlobService()
+	container := blobService.GetContainerReference("images")
+
+	sdkContainerUri, err := container.GetSASURI(mainStorage.ContainerSASOptions{
+		ContainerSASPermissions: mainStorage.ContainerSASPermissions{
+			BlobServiceSASPermissions: mainStorage.BlobServiceSASPermissions{
+				Read:  true,
+				Write: true,
+			},
+			List: true,
+		},
+		SASOptions: mainStorage.SASOptions{
+			Start:    start,
+			Expiry:   expiry,
+			UseHTTPS: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error computing the Container SAS using the SDK: %+v", err)
+	}
+
+	sdkBlobUri, err := container.GetBlobReference("logo.png").GetSASURI(mainStorage.BlobSASOptions{
+		BlobServiceSASPermissions: mainStorage.BlobServiceSASPermissions{
+			Read: true,
+		},
+		OverrideHeaders: mainStorage.OverrideHeaders{
+			CacheControl:       "max-age=5",
+			ContentDisposition: "attachment; filename=\"logo.png\"",
+			ContentEncoding:    "gzip",
+			ContentLanguage:    "en-GB",
+			ContentType:        "image/png",
+		},
+		SASOptions: mainStorage.SASOptions{
+			Expiry:     expiry,
+			IP:         "168.1.5.60-168.1.5.70",
+			UseHTTPS:   true,
+			Identifier: "policy1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error computing the Blob SAS using the SDK: %+v", err)
+	}
+
+	testCases := []struct {
+		name   string
+		input  storageBlobServiceSas
+		sdkUri string
+	}{
+		{
+			name: "Container",
+			input: storageBlobServiceSas{
+				AccountName:   accountName,
+				AccountKey:    accountKey,
+				ContainerName: "images",
+				Permissions:   "rwl",
+				Start:         start.Format(time.RFC3339),
+				Expiry:        expiry.Format(time.RFC3339),
+				Protocol:      "https",
+			},
+			sdkUri: sdkContainerUri,
+		},
+		{
+			name: "Blob",
+			input: storageBlobServiceSas{
+				AccountName:        accountName,
+				AccountKey:         accountKey,
+				ContainerName:      "images",
+				BlobName:           "logo.png",
+				Permissions:        "r",
+				Expiry:             expiry.Format(time.RFC3339),
+				Identifier:         "policy1",
+				IPAddress:          "168.1.5.60-168.1.5.70",
+				Protocol:           "https",
+				CacheControl:       "max-age=5",
+				ContentDisposition: "attachment; filename=\"logo.png\"",
+				ContentEncoding:    "gzip",
+				ContentLanguage:    "en-GB",
+				ContentType:        "image/png",
+			},
+			sdkUri: sdkBlobUri,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.name)
+
+		computedToken, err := computeStorageBlobServiceSasToken(test.input)
+		if err != nil {
+			t.Fatalf("Error computing the Service SAS: %+v", err)
+		}
+
+		sdkUri, err := url.Parse(test.sdkUri)
+		if err != nil {
+			t.Fatalf("Error parsing the SAS URI %q from the SDK: %+v", test.sdkUri, err)
+		}
+
+		expected := sdkUri.Query().Get("sig")
+		if signature := storageSasTokenSignature(t, computedToken); signature != expected {
+			t.Fatalf("Expected the signature computed by the SDK %q but got %q", expected, signature)
+		}
+	}
+}
+
+func storageSasTokenSignature(t *testing.T, token string) string {
+	values, err := url.ParseQuery(token[1:])
+	if err != nil {
+		t.Fatalf("Error parsing the Service SAS %q: %+v", token, err)
+	}
+
+	return values.Get("sig")
+}
+
+func TestComputeStorageBlobServiceSasToken_invalidKey(t *testing.T) {
+	input := storageBlobServiceSas{
+		AccountName:   "azurermtestsa0",
+		AccountKey:    "not-base64!",
+		ContainerName: "images",
+		Permissions:   "r",
+		Expiry:        "2020-03-20T04:00:00Z",
+		Protocol:      "https",
+	}
+
+	if _, err := computeStorageBlobServiceSasToken(input); err == nil {
+		t.Fatalf("Expected an error decoding the Storage Account Key but got none")
+	}
+}
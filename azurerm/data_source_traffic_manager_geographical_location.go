@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -69,3 +69,25 @@ func filterGeographicalRegions(input *[]trafficmanager.Region, name string) *tra
 func geographicalRegionIsMatch(input *trafficmanager.Region, name string) bool {
 	return strings.EqualFold(*input.Name, name)
 }
+
+// flattenGeographicalRegionCodes returns the codes of the region and all of the regions nested within it
+func flattenGeographicalRegionCodes(input *trafficmanager.Region) map[string]bool {
+	codes := make(map[string]bool)
+	if input == nil {
+		return codes
+	}
+
+	if input.Code != nil {
+		codes[*input.Code] = true
+	}
+
+	if regions := input.Regions; regions != nil {
+		for _, region := range *regions {
+			for code := range flattenGeographicalRegionCodes(&region) {
+				codes[code] = true
+			}
+		}
+	}
+
+	return codes
+}
@@ -11,3 +11,7 @@ import (
 
 // This file contains feature flags for functionality which will prove more challenging to implement en-mass
 var requireResourcesToBeImported = strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "true")
+
+// when enabled, address prefixes in Network Security & Firewall Rules which look like a Service Tag are validated
+// against the catalog embedded in the provider, rather than being rejected by the API part-way through an apply
+var validateNetworkServiceTags = strings.EqualFold(os.Getenv("ARM_PROVIDER_VALIDATE_SERVICE_TAGS"), "true")
@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/%s/%s", subscriptionId, resourceGroup, frontDoorName, resourceType, name)
+}
+
+// the API returns sub resource ID's with inconsistent casing, so the name is taken from the last segment
+func frontDoorSubResourceName(id *string) string {
+	if id == nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.TrimSuffix(*id, "/"), "/")
+	return segments[len(segments)-1]
+}
+
+func resourceArmFrontDoorCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	return validateFrontDoorSettings(
+		d.Get("name").(string),
+		d.Get("routing_rule").([]interface{}),
+		d.Get("frontend_endpoint").([]interface{}),
+		d.Get("backend_pool").([]interface{}),
+		d.Get("backend_pool_load_balancing").([]interface{}),
+		d.Get("backend_pool_health_probe").([]interface{}))
+}
+
+// validateFrontDoorSettings cross-checks the names referenced between the blocks of a Front Door, since the API
+// only reports these as a generic Bad Request once the whole Front Door has been submitted
+func validateFrontDoorSettings(name string, routingRules, frontendEndpoints, backendPools, loadBalancingSettings, healthProbeSettings []interface{}) error {
+	frontendEndpointNames, err := frontDoorBlockNames("frontend_endpoint", frontendEndpoints)
+	if err != nil {
+		return err
+	}
+	backendPoolNames, err := frontDoorBlockNames("backend_pool", backendPools)
+	if err != nil {
+		return err
+	}
+	loadBalancingNames, err := frontDoorBlockNames("backend_pool_load_balancing", loadBalancingSettings)
+	if err != nil {
+		return err
+	}
+	healthProbeNames, err := frontDoorBlockNames("backend_pool_health_probe", healthProbeSettings)
+	if err != nil {
+		return err
+	}
+	if _, err := frontDoorBlockNames("routing_rule", routingRules); err != nil {
+		return err
+	}
+
+	for _, raw := range routingRules {
+		rule := raw.(map[string]interface{})
+		ruleName := rule["name"].(string)
+
+		for _, v := range rule["frontend_endpoints"].([]interface{}) {
+			endpointName, _ := v.(string)
+			if endpointName != "" && !frontendEndpointNames[endpointName] {
+				return fmt.Errorf("`routing_rule` %q references the `frontend_endpoint` %q which is not defined", ruleName, endpointName)
+			}
+		}
+
+		for _, fc := range rule["forwarding_configuration"].([]interface{}) {
+			if fc == nil {
+				continue
+			}
+			poolName := fc.(map[string]interface{})["backend_pool_name"].(string)
+			if poolName != "" && !backendPoolNames[poolName] {
+				return fmt.Errorf("`routing_rule` %q references the `backend_pool` %q which is not defined", ruleName, poolName)
+			}
+		}
+	}
+
+	for _, raw := range backendPools {
+		pool := raw.(map[string]interface{})
+		poolName := pool["name"].(string)
+
+		if v := pool["load_balancing_name"].(string); v != "" && !loadBalancingNames[v] {
+			return fmt.Errorf("`backend_pool` %q references the `backend_pool_load_balancing` %q which is not defined", poolName, v)
+		}
+		if v := pool["health_probe_name"].(string); v != "" && !healthProbeNames[v] {
+			return fmt.Errorf("`backend_pool` %q references the `backend_pool_health_probe` %q which is not defined", poolName, v)
+		}
+	}
+
+	defaultHostName := fmt.Sprintf("%s.azurefd.net", name)
+	foundDefaultHostName := false
+	for _, raw := range frontendEndpoints {
+		endpoint := raw.(map[string]interface{})
+		endpointName := endpoint["name"].(string)
+		hostName := endpoint["host_name"].(string)
+
+		isDefaultHostName := strings.EqualFold(hostName, defaultHostName)
+		if isDefaultHostName {
+			foundDefaultHostName = true
+		}
+
+		customHttpsEnabled := endpoint["custom_https_provisioning_enabled"].(bool)
+		customHttpsConfigs := endpoint["custom_https_configuration"].([]interface{})
+		if customHttpsEnabled && isDefaultHostName {
+			return fmt.Errorf("`frontend_endpoint` %q: `custom_https_provisioning_enabled` cannot be set on the default host name %q", endpointName, hostName)
+		}
+
+		for _, c := range customHttpsConfigs {
+			if c == nil {
+				continue
+			}
+			config := c.(map[string]interface{})
+			vaultId := config["azure_key_vault_certificate_vault_id"].(string)
+			secretName := config["azure_key_vault_certificate_secret_name"].(string)
+			secretVersion := config["azure_key_vault_certificate_secret_version"].(string)
+
+			if config["certificate_source"].(string) == string(frontdoor.CertificateSourceAzureKeyVault) {
+				if vaultId == "" || secretName == "" || secretVersion == "" {
+					return fmt.Errorf("`frontend_endpoint` %q: `azure_key_vault_certificate_vault_id`, `azure_key_vault_certificate_secret_name` and `azure_key_vault_certificate_secret_version` must be set when `certificate_source` is `AzureKeyVault`", endpointName)
+				}
+			} else if vaultId != "" || secretName != "" || secretVersion != "" {
+				return fmt.Errorf("`frontend_endpoint` %q: the `azure_key_vault_certificate_*` fields can only be set when `certificate_source` is `AzureKeyVault`", endpointName)
+			}
+		}
+	}
+
+	if name != "" && !foundDefaultHostName {
+		return fmt.Errorf("A `frontend_endpoint` with the `host_name` %q must be defined", defaultHostName)
+	}
+
+	return nil
+}
+
+func frontDoorBlockNames(blockName string, input []interface{}) (map[string]bool, error) {
+	names := make(map[string]bool)
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		name := raw.(map[string]interface{})["name"].(string)
+		if name == "" {
+			continue
+		}
+		if names[name] {
+			return nil, fmt.Errorf("The name %q is used by more than one `%s` block", name, blockName)
+		}
+		names[name] = true
+	}
+
+	return names, nil
+}
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFrontDoorSettings(t *testing.T) {
+	routingRule := func(endpoints []interface{}, pool string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "rule1",
+			"frontend_endpoints": endpoints,
+			"forwarding_configuration": []interface{}{
+				map[string]interface{}{
+					"backend_pool_name": pool,
+				},
+			},
+		}
+	}
+	backendPool := func(loadBalancing, healthProbe string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                "pool1",
+			"load_balancing_name": loadBalancing,
+			"health_probe_name":   healthProbe,
+		}
+	}
+	frontendEndpoint := func(name, hostName string, customHttps bool, configs []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                              name,
+			"host_name":                         hostName,
+			"custom_https_provisioning_enabled": customHttps,
+			"custom_https_configuration":        configs,
+		}
+	}
+	loadBalancing := []interface{}{map[string]interface{}{"name": "lb1"}}
+	healthProbes := []interface{}{map[string]interface{}{"name": "hp1"}}
+
+	cases := []struct {
+		Name              string
+		RoutingRules      []interface{}
+		FrontendEndpoints []interface{}
+		BackendPools      []interface{}
+		ErrorContains     string
+	}{
+		{
+			Name:              "Valid",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp1")},
+		},
+		{
+			Name:              "Unknown Values Are Skipped",
+			RoutingRules:      []interface{}{routingRule([]interface{}{""}, "")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("", "")},
+		},
+		{
+			Name:              "Undefined Frontend Endpoint",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe2"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains:     "`frontend_endpoint` \"fe2\"",
+		},
+		{
+			Name:              "Undefined Backend Pool",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool2")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains:     "`backend_pool` \"pool2\"",
+		},
+		{
+			Name:              "Undefined Load Balancing Settings",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb2", "hp1")},
+			ErrorContains:     "`backend_pool_load_balancing` \"lb2\"",
+		},
+		{
+			Name:              "Undefined Health Probe",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp2")},
+			ErrorContains:     "`backend_pool_health_probe` \"hp2\"",
+		},
+		{
+			Name:         "Duplicate Frontend Endpoint Names",
+			RoutingRules: []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{
+				frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{}),
+				frontendEndpoint("fe1", "www.example.com", false, []interface{}{}),
+			},
+			BackendPools:  []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains: "more than one `frontend_endpoint`",
+		},
+		{
+			Name:              "Missing Default Host Name",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "www.example.com", false, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains:     "example-fd.azurefd.net",
+		},
+		{
+			Name:              "Custom HTTPS On Default Host Name",
+			RoutingRules:      []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{frontendEndpoint("fe1", "example-fd.azurefd.net", true, []interface{}{})},
+			BackendPools:      []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains:     "cannot be set on the default host name",
+		},
+		{
+			Name:         "Key Vault Certificate Missing Secret",
+			RoutingRules: []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{
+				frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{}),
+				frontendEndpoint("fe2", "www.example.com", true, []interface{}{
+					map[string]interface{}{
+						"certificate_source":                         "AzureKeyVault",
+						"azure_key_vault_certificate_vault_id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+						"azure_key_vault_certificate_secret_name":    "",
+						"azure_key_vault_certificate_secret_version": "",
+					},
+				}),
+			},
+			BackendPools:  []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains: "must be set when `certificate_source` is `AzureKeyVault`",
+		},
+		{
+			Name:         "Front Door Certificate With Key Vault Fields",
+			RoutingRules: []interface{}{routingRule([]interface{}{"fe1"}, "pool1")},
+			FrontendEndpoints: []interface{}{
+				frontendEndpoint("fe1", "example-fd.azurefd.net", false, []interface{}{}),
+				frontendEndpoint("fe2", "www.example.com", true, []interface{}{
+					map[string]interface{}{
+						"certificate_source":                         "FrontDoor",
+						"azure_key_vault_certificate_vault_id":       "",
+						"azure_key_vault_certificate_secret_name":    "secret1",
+						"azure_key_vault_certificate_secret_version": "",
+					},
+				}),
+			},
+			BackendPools:  []interface{}{backendPool("lb1", "hp1")},
+			ErrorContains: "can only be set when `certificate_source` is `AzureKeyVault`",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateFrontDoorSettings("example-fd", v.RoutingRules, v.FrontendEndpoints, v.BackendPools, loadBalancing, healthProbes)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", v.Name, v.ErrorContains, err)
+		}
+	}
+}
@@ -16,6 +16,16 @@ type KeyVaultChildID struct {
 }
 
 func ParseKeyVaultChildID(id string) (*KeyVaultChildID, error) {
+	return parseKeyVaultChildID(id, true)
+}
+
+// ParseKeyVaultChildIDVersionOptional parses a Key Vault Child ID which may omit the Version
+// (e.g. when the latest version of a Key should be used), in which case the Version is empty
+func ParseKeyVaultChildIDVersionOptional(id string) (*KeyVaultChildID, error) {
+	return parseKeyVaultChildID(id, false)
+}
+
+func parseKeyVaultChildID(id string, requireVersion bool) (*KeyVaultChildID, error) {
 	// example: https://tharvey-keyvault.vault.azure.net/type/bird/fdf067c93bbb4b22bff4d8b7a9a56217
 	idURL, err := url.ParseRequestURI(id)
 	if err != nil {
@@ -29,14 +39,19 @@ func ParseKeyVaultChildID(id string) (*KeyVaultChildID, error) {
 
 	components := strings.Split(path, "/")
 
-	if len(components) != 3 {
+	if requireVersion && len(components) != 3 {
 		return nil, fmt.Errorf("Azure KeyVault Child Id should have 3 segments, got %d: '%s'", len(components), path)
 	}
+	if !requireVersion && len(components) != 2 && len(components) != 3 {
+		return nil, fmt.Errorf("Azure KeyVault Child Id should have 2 or 3 segments, got %d: '%s'", len(components), path)
+	}
 
 	childId := KeyVaultChildID{
 		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host),
 		Name:            components[1],
-		Version:         components[2],
+	}
+	if len(components) == 3 {
+		childId.Version = components[2]
 	}
 
 	return &childId, nil
@@ -72,3 +87,22 @@ func ValidateKeyVaultChildId(i interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+func ValidateKeyVaultChildIdVersionOptional(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = validate.NoEmptyStrings(i, k); len(errors) > 0 {
+		return warnings, errors
+	}
+
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("Expected %s to be a string!", k))
+		return warnings, errors
+	}
+
+	if _, err := ParseKeyVaultChildIDVersionOptional(v); err != nil {
+		errors = append(errors, fmt.Errorf("Error parsing Key Vault Child ID: %s", err))
+		return warnings, errors
+	}
+
+	return warnings, errors
+}
@@ -2,6 +2,9 @@ package azure
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func ValidateResourceID(i interface{}, k string) (warnings []string, errors []error) {
@@ -32,3 +35,29 @@ func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []er
 
 	return ValidateResourceID(i, k)
 }
+
+// ValidateResourceIDOfType validates that the value is the ID of a resource of the given type, for
+// example `ValidateResourceIDOfType("Microsoft.Network", "ddosProtectionPlans")` - rather than the ID
+// of a resource nested within it
+func ValidateResourceIDOfType(provider, resourceType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		id, err := ParseAzureResourceID(v)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+			return
+		}
+
+		segments := strings.Split(strings.Trim(v, "/"), "/")
+		if !strings.EqualFold(id.Provider, provider) || !strings.EqualFold(segments[len(segments)-2], resourceType) {
+			errors = append(errors, fmt.Errorf("%q must be the ID of a %s/%s resource, got %q", k, provider, resourceType, v))
+		}
+
+		return
+	}
+}
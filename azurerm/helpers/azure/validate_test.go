@@ -90,3 +90,53 @@ func TestAzureResourceIDOrEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureResourceIDOfType(t *testing.T) {
+	cases := []struct {
+		ID     string
+		Errors int
+	}{
+		{
+			ID:     "",
+			Errors: 1,
+		},
+		{
+			ID:     "nonsense",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/ddosProtectionPlans/plan1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/ddosProtectionPlans/plan1/children/child1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/ddosProtectionPlans/plan1",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/ddosprotectionplans/plan1",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			_, errors := ValidateResourceIDOfType("Microsoft.Network", "ddosProtectionPlans")(tc.ID, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ValidateResourceIDOfType to have %d not %d errors for %q", tc.Errors, len(errors), tc.ID)
+			}
+		})
+	}
+}
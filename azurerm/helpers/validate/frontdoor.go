@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func FrontDoorName() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		// Length should be between 5 and 64.
+		if warnings, errors = validation.StringLenBetween(5, 64)(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		// Naming rule.
+		regexStr := "^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$"
+		errMsg := "Front Door name can only include alphanumeric characters and hyphens, and must start and end with an alphanumeric character."
+		if warnings, errors = validation.StringMatch(regexp.MustCompile(regexStr), errMsg)(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		return warnings, errors
+	}
+}
+
+func FrontDoorFirewallPolicyName() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		// Length should be between 1 and 128.
+		if warnings, errors = validation.StringLenBetween(1, 128)(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		// Naming rule.
+		regexStr := "^[a-zA-Z][a-zA-Z0-9]*$"
+		errMsg := "Front Door Firewall Policy name must start with a letter and can only include alphanumeric characters."
+		if warnings, errors = validation.StringMatch(regexp.MustCompile(regexStr), errMsg)(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		return warnings, errors
+	}
+}
@@ -0,0 +1,60 @@
+package validate
+
+import "testing"
+
+func TestValidateFrontDoorName(t *testing.T) {
+	validNames := []string{
+		"valid-name",
+		"valid02-name",
+		"validName1",
+		"a1b2c",
+	}
+	for _, v := range validNames {
+		_, errors := FrontDoorName()(v, "valid")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Front Door Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"abc",
+		"invalid!",
+		"-invalid",
+		"invalid-",
+		"invalid_name",
+		"a1234567890123456789012345678901234567890123456789012345678901234",
+	}
+	for _, v := range invalidNames {
+		_, errors := FrontDoorName()(v, "invalid")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Front Door Name", v)
+		}
+	}
+}
+
+func TestValidateFrontDoorFirewallPolicyName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"validName",
+		"validName01",
+	}
+	for _, v := range validNames {
+		_, errors := FrontDoorFirewallPolicyName()(v, "valid")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Front Door Firewall Policy Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1invalid",
+		"invalid-name",
+		"invalid_name",
+	}
+	for _, v := range invalidNames {
+		_, errors := FrontDoorFirewallPolicyName()(v, "invalid")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Front Door Firewall Policy Name", v)
+		}
+	}
+}
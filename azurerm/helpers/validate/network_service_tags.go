@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// networkServiceTags is generated from the Service Tag catalog for the Public cloud (see
+// `network_service_tags_generated.go`) - it can be refreshed by running `go generate` within this package
+//go:generate bash ../../../scripts/update-network-service-tags.sh
+
+// these tags are built into Network Security Groups and aren't returned as a part of the Service Tag catalog
+var networkDefaultServiceTags = []string{
+	"AzureLoadBalancer",
+	"Internet",
+	"VirtualNetwork",
+}
+
+var networkServiceTagNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(\.[a-zA-Z0-9]+)*$`)
+
+var networkServiceTagsLookup = buildNetworkServiceTagsLookup()
+
+func buildNetworkServiceTagsLookup() map[string]bool {
+	lookup := make(map[string]bool, len(networkServiceTags)+len(networkDefaultServiceTags))
+	for _, v := range networkServiceTags {
+		lookup[strings.ToLower(v)] = true
+	}
+	for _, v := range networkDefaultServiceTags {
+		lookup[strings.ToLower(v)] = true
+	}
+
+	return lookup
+}
+
+// IsKnownNetworkServiceTag returns whether the name is a known Service Tag, ignoring casing
+func IsKnownNetworkServiceTag(name string) bool {
+	return networkServiceTagsLookup[strings.ToLower(name)]
+}
+
+// NetworkServiceTag validates that the value is a known Service Tag, such as `Storage` or `Storage.WestEurope`
+func NetworkServiceTag(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !IsKnownNetworkServiceTag(v) {
+		errors = append(errors, fmt.Errorf("%q is not a known Service Tag: %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// NetworkAddressPrefixOrServiceTag validates the value of a Network Security or Firewall Rule address field - which
+// can be an IP Address, a CIDR, a range, `*` or a Service Tag. Only values which look like a Service Tag are checked
+// against the catalog, everything else is left for the API to validate.
+func NetworkAddressPrefixOrServiceTag(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" || v == "*" || net.ParseIP(v) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(v); err == nil {
+		return
+	}
+	if !networkServiceTagNameRegex.MatchString(v) {
+		return
+	}
+
+	if !IsKnownNetworkServiceTag(v) {
+		errors = append(errors, fmt.Errorf("%q is neither an IP Address, CIDR or a known Service Tag: %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,62 @@
+package validate
+
+import "testing"
+
+func TestNetworkServiceTag(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{Value: "", Errors: 1},
+		{Value: "Storage", Errors: 0},
+		{Value: "storage", Errors: 0},
+		{Value: "Storage.WestEurope", Errors: 0},
+		{Value: "Storage.WestEurop", Errors: 1},
+		{Value: "AzureCloud.westeurope", Errors: 0},
+		{Value: "AzureFrontDoor.Backend", Errors: 0},
+		{Value: "VirtualNetwork", Errors: 0},
+		{Value: "AzureLoadBalancer", Errors: 0},
+		{Value: "Internet", Errors: 0},
+		{Value: "Strorage", Errors: 1},
+		{Value: "10.0.0.0/16", Errors: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := NetworkServiceTag(tc.Value, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected NetworkServiceTag to have %d not %d errors for %q", tc.Errors, len(errors), tc.Value)
+			}
+		})
+	}
+}
+
+func TestNetworkAddressPrefixOrServiceTag(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{Value: "", Errors: 0},
+		{Value: "*", Errors: 0},
+		{Value: "10.0.0.1", Errors: 0},
+		{Value: "10.0.0.0/16", Errors: 0},
+		{Value: "10.0.0.1-10.0.0.10", Errors: 0},
+		{Value: "fe80::1", Errors: 0},
+		{Value: "ace:cab:deca::/48", Errors: 0},
+		{Value: "Sql.EastUS2", Errors: 0},
+		{Value: "VirtualNetwork", Errors: 0},
+		{Value: "Storage.WestEurop", Errors: 1},
+		{Value: "VirtualNetwrok", Errors: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := NetworkAddressPrefixOrServiceTag(tc.Value, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected NetworkAddressPrefixOrServiceTag to have %d not %d errors for %q", tc.Errors, len(errors), tc.Value)
+			}
+		})
+	}
+}
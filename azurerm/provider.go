@@ -95,6 +95,18 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
+
+			"storage_allow_shared_key_fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_ALLOW_SHARED_KEY_FALLBACK", false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -134,6 +146,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_monitor_log_profile":                   dataSourceArmMonitorLogProfile(),
 			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
 			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
+			"azurerm_network_service_tags":                  dataSourceArmNetworkServiceTags(),
 			"azurerm_notification_hub_namespace":            dataSourceNotificationHubNamespace(),
 			"azurerm_notification_hub":                      dataSourceNotificationHub(),
 			"azurerm_platform_image":                        dataSourceArmPlatformImage(),
@@ -150,6 +163,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_shared_image_version":                  dataSourceArmSharedImageVersion(),
 			"azurerm_shared_image":                          dataSourceArmSharedImage(),
 			"azurerm_snapshot":                              dataSourceArmSnapshot(),
+			"azurerm_storage_account_blob_container_sas":    dataSourceArmStorageAccountBlobContainerSharedAccessSignature(),
 			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
 			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
 			"azurerm_subnet":                                dataSourceArmSubnet(),
@@ -187,6 +201,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_batch_account":                          resourceArmBatchAccount(),
 			"azurerm_batch_pool":                             resourceArmBatchPool(),
 			"azurerm_cdn_endpoint":                           resourceArmCdnEndpoint(),
+			"azurerm_cdn_endpoint_custom_domain":             resourceArmCdnEndpointCustomDomain(),
 			"azurerm_cdn_profile":                            resourceArmCdnProfile(),
 			"azurerm_cognitive_account":                      resourceArmCognitiveAccount(),
 			"azurerm_container_group":                        resourceArmContainerGroup(),
@@ -223,12 +238,15 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_eventhub_namespace":                     resourceArmEventHubNamespace(),
 			"azurerm_eventhub":                               resourceArmEventHub(),
 			"azurerm_express_route_circuit_authorization":    resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_connection":       resourceArmExpressRouteCircuitConnection(),
 			"azurerm_express_route_circuit_peering":          resourceArmExpressRouteCircuitPeering(),
 			"azurerm_express_route_circuit":                  resourceArmExpressRouteCircuit(),
 			"azurerm_firewall_application_rule_collection":   resourceArmFirewallApplicationRuleCollection(),
 			"azurerm_firewall_network_rule_collection":       resourceArmFirewallNetworkRuleCollection(),
 			"azurerm_firewall":                               resourceArmFirewall(),
 			"azurerm_function_app":                           resourceArmFunctionApp(),
+			"azurerm_frontdoor":                              resourceArmFrontDoor(),
+			"azurerm_frontdoor_firewall_policy":              resourceArmFrontDoorFirewallPolicy(),
 			"azurerm_image":                                  resourceArmImage(),
 			"azurerm_iothub_consumer_group":                  resourceArmIotHubConsumerGroup(),
 			"azurerm_iothub":                                 resourceArmIotHub(),
@@ -241,6 +259,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_lb_backend_address_pool":                resourceArmLoadBalancerBackendAddressPool(),
 			"azurerm_lb_nat_pool":                            resourceArmLoadBalancerNatPool(),
 			"azurerm_lb_nat_rule":                            resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_outbound_rule":                       resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_probe":                               resourceArmLoadBalancerProbe(),
 			"azurerm_lb_rule":                                resourceArmLoadBalancerRule(),
 			"azurerm_lb":                                     resourceArmLoadBalancer(),
@@ -274,6 +293,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_mysql_firewall_rule":                    resourceArmMySqlFirewallRule(),
 			"azurerm_mysql_server":                           resourceArmMySqlServer(),
 			"azurerm_mysql_virtual_network_rule":             resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_nat_gateway":                            resourceArmNatGateway(),
 			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
 			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
@@ -294,7 +314,10 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_endpoint":                                                       resourceArmPrivateEndpoint(),
+			"azurerm_private_link_service":                                                   resourceArmPrivateLinkService(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
+			"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
 			"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
@@ -304,6 +327,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
+			"azurerm_route_filter":                                                           resourceArmRouteFilter(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
 			"azurerm_route":                                                                  resourceArmRoute(),
 			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
@@ -333,18 +357,26 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_sql_server":                                                             resourceArmSqlServer(),
 			"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+			"azurerm_storage_account_customer_managed_key":                                   resourceArmStorageAccountCustomerManagedKey(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
+			"azurerm_storage_share_directory":                                                resourceArmStorageShareDirectory(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
+			"azurerm_storage_table_entity":                                                   resourceArmStorageTableEntity(),
+			"azurerm_subnet_nat_gateway_association":                                         resourceArmSubnetNatGatewayAssociation(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
+			"azurerm_subnet_service_endpoint_storage_policy":                                 resourceArmSubnetServiceEndpointStoragePolicy(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_hub_connection":                                                 resourceArmVirtualHubConnection(),
+			"azurerm_virtual_hub":                                                            resourceArmVirtualHub(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
@@ -353,6 +385,11 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
+			"azurerm_web_application_firewall_policy":                                        resourceArmWebApplicationFirewallPolicy(),
+			"azurerm_vpn_gateway_connection":                                                 resourceArmVpnGatewayConnection(),
+			"azurerm_vpn_gateway":                                                            resourceArmVpnGateway(),
+			"azurerm_vpn_site":                                                               resourceArmVpnSite(),
 		},
 	}
 
@@ -387,13 +424,15 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		storageUseAzureAD := d.Get("storage_use_azuread").(bool)
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, storageUseAzureAD)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.storageAllowSharedKeyFallback = d.Get("storage_allow_shared_key_fallback").(bool)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
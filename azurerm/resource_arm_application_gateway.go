@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -335,6 +335,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Optional: true,
 						},
 
+						"firewall_policy_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Network", "ApplicationGatewayWebApplicationFirewallPolicies"),
+						},
+
 						"frontend_ip_configuration_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -714,6 +720,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 										Required: true,
 									},
 
+									"firewall_policy_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Network", "ApplicationGatewayWebApplicationFirewallPolicies"),
+									},
+
 									"backend_address_pool_id": {
 										Type:     schema.TypeString,
 										Computed: true,
@@ -750,6 +762,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 				},
 			},
 
+			"firewall_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Network", "ApplicationGatewayWebApplicationFirewallPolicies"),
+			},
+
 			"waf_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -913,6 +931,12 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		gateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
 	}
 
+	if firewallPolicyID := d.Get("firewall_policy_id").(string); firewallPolicyID != "" {
+		gateway.ApplicationGatewayPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
@@ -964,6 +988,12 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil {
+		firewallPolicyID := ""
+		if props.FirewallPolicy != nil && props.FirewallPolicy.ID != nil {
+			firewallPolicyID = *props.FirewallPolicy.ID
+		}
+		d.Set("firewall_policy_id", firewallPolicyID)
+
 		flattenedCerts := flattenApplicationGatewayAuthenticationCertificates(props.AuthenticationCertificates, d)
 		if setErr := d.Set("authentication_certificate", flattenedCerts); setErr != nil {
 			return fmt.Errorf("Error setting `authentication_certificate`: %+v", setErr)
@@ -1427,6 +1457,12 @@ func expandApplicationGatewayHTTPListeners(d *schema.ResourceData, gatewayID str
 			}
 		}
 
+		if firewallPolicyID := v["firewall_policy_id"].(string); firewallPolicyID != "" {
+			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+				ID: utils.String(firewallPolicyID),
+			}
+		}
+
 		results = append(results, listener)
 	}
 
@@ -1498,6 +1534,10 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 				output["require_sni"] = *sni
 			}
 
+			if policy := props.FirewallPolicy; policy != nil && policy.ID != nil {
+				output["firewall_policy_id"] = *policy.ID
+			}
+
 			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 		}
 
@@ -2096,6 +2136,12 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 				}
 			}
 
+			if firewallPolicyID := ruleConfigMap["firewall_policy_id"].(string); firewallPolicyID != "" {
+				rule.ApplicationGatewayPathRulePropertiesFormat.FirewallPolicy = &network.SubResource{
+					ID: utils.String(firewallPolicyID),
+				}
+			}
+
 			pathRules = append(pathRules, rule)
 		}
 
@@ -2190,6 +2236,10 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 							ruleOutput["backend_http_settings_id"] = *backend.ID
 						}
 
+						if policy := ruleProps.FirewallPolicy; policy != nil && policy.ID != nil {
+							ruleOutput["firewall_policy_id"] = *policy.ID
+						}
+
 						pathOutputs := make([]interface{}, 0)
 						if paths := ruleProps.Paths; paths != nil {
 							for _, rulePath := range *paths {
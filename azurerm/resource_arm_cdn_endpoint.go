@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2019-04-15/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -174,6 +177,226 @@ func resourceArmCdnEndpoint() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"url_path_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(append(cdnDeliveryRuleConditionOperators, "Wildcard"), false),
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"negate_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"transforms": cdnDeliveryRuleConditionTransformsSchema(),
+								},
+							},
+						},
+
+						"url_file_extension_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cdnDeliveryRuleConditionOperators, false),
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"negate_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"transforms": cdnDeliveryRuleConditionTransformsSchema(),
+								},
+							},
+						},
+
+						"request_header_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"selector": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cdnDeliveryRuleConditionOperators, false),
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"negate_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"transforms": cdnDeliveryRuleConditionTransformsSchema(),
+								},
+							},
+						},
+
+						"request_scheme_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"HTTP",
+												"HTTPS",
+											}, false),
+										},
+									},
+
+									"negate_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+
+						"cache_expiration_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"behavior": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(cdn.BypassCache),
+											string(cdn.Override),
+											string(cdn.SetIfMissing),
+										}, false),
+									},
+									"duration": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCdnEndpointCacheDuration,
+									},
+								},
+							},
+						},
+
+						"url_redirect_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"redirect_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Found",
+											"Moved",
+											"PermanentRedirect",
+											"TemporaryRedirect",
+										}, false),
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "MatchRequest",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Http",
+											"Https",
+											"MatchRequest",
+										}, false),
+									},
+
+									"path": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`path` must begin with a `/`"),
+									},
+
+									"hostname": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"query_string": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"fragment": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"request_header_action": cdnDeliveryRuleHeaderActionSchema(),
+
+						"response_header_action": cdnDeliveryRuleHeaderActionSchema(),
+					},
+				},
+			},
+
 			"host_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -256,6 +479,14 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointProperties.Origins = &origins
 	}
 
+	deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d, meta)
+	if err != nil {
+		return fmt.Errorf("Error expanding `delivery_rule`: %s", err)
+	}
+	if deliveryPolicy != nil && len(*deliveryPolicy.Rules) > 0 {
+		endpoint.EndpointProperties.DeliveryPolicy = deliveryPolicy
+	}
+
 	future, err := client.Create(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error creating CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
@@ -321,6 +552,15 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointPropertiesUpdateParameters.ProbePath = utils.String(probePath)
 	}
 
+	if d.HasChange("delivery_rule") {
+		deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d, meta)
+		if err != nil {
+			return fmt.Errorf("Error expanding `delivery_rule`: %s", err)
+		}
+
+		endpoint.EndpointPropertiesUpdateParameters.DeliveryPolicy = deliveryPolicy
+	}
+
 	future, err := endpointsClient.Update(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error updating CDN Endpoint %q (Profile %q / Resource Group %q): %s", name, profileName, resourceGroup, err)
@@ -391,6 +631,11 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 		if err := d.Set("origin", origins); err != nil {
 			return fmt.Errorf("Error setting `origin`: %+v", err)
 		}
+
+		deliveryRules := flattenArmCdnEndpointDeliveryPolicy(props.DeliveryPolicy)
+		if err := d.Set("delivery_rule", deliveryRules); err != nil {
+			return fmt.Errorf("Error setting `delivery_rule`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -572,3 +817,434 @@ func flattenAzureRMCdnEndpointOrigin(input *[]cdn.DeepCreatedOrigin) []interface
 
 	return results
 }
+
+// cdnDeliveryRuleConditionOperators are the operators supported by the URL Path, URL File Extension and Request Header
+// conditions - URL Path conditions additionally support `Wildcard`
+var cdnDeliveryRuleConditionOperators = []string{
+	"Any",
+	"BeginsWith",
+	"Contains",
+	"EndsWith",
+	"Equal",
+	"GreaterThan",
+	"GreaterThanOrEqual",
+	"LessThan",
+	"LessThanOrEqual",
+}
+
+func cdnDeliveryRuleConditionTransformsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Lowercase",
+				"Uppercase",
+			}, false),
+		},
+	}
+}
+
+func cdnDeliveryRuleHeaderActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Append",
+						"Delete",
+						"Overwrite",
+					}, false),
+				},
+
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// expandArmCdnEndpointDeliveryPolicy builds the Delivery Policy for the Endpoint, which is only supported for
+// Endpoints within a `Standard_Microsoft` Profile - so nil is returned for other Profiles without any rules
+func expandArmCdnEndpointDeliveryPolicy(d *schema.ResourceData, meta interface{}) (*cdn.EndpointPropertiesUpdateParametersDeliveryPolicy, error) {
+	client := meta.(*ArmClient).cdnProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	inputRules := d.Get("delivery_rule").([]interface{})
+
+	profile, err := client.Get(ctx, resourceGroup, profileName)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving CDN Profile %q (Resource Group %q): %+v", profileName, resourceGroup, err)
+	}
+
+	if profile.Sku == nil || profile.Sku.Name != cdn.StandardMicrosoft {
+		if len(inputRules) > 0 {
+			return nil, fmt.Errorf("`delivery_rule` can only be used with CDN Profiles using the `%s` SKU", string(cdn.StandardMicrosoft))
+		}
+
+		return nil, nil
+	}
+
+	rules := make([]cdn.DeliveryRule, 0)
+	for _, v := range inputRules {
+		rule, err := expandArmCdnEndpointDeliveryRule(v.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	return &cdn.EndpointPropertiesUpdateParametersDeliveryPolicy{
+		Description: utils.String(""),
+		Rules:       &rules,
+	}, nil
+}
+
+func expandArmCdnEndpointDeliveryRule(input map[string]interface{}) (*cdn.DeliveryRule, error) {
+	order := input["order"].(int)
+
+	conditions := make([]cdn.BasicDeliveryRuleCondition, 0)
+	for _, v := range input["url_path_condition"].([]interface{}) {
+		condition := v.(map[string]interface{})
+		conditions = append(conditions, cdn.DeliveryRuleURLPathCondition{
+			Name: cdn.NameURLPath,
+			Parameters: &cdn.URLPathMatchConditionParameters{
+				OdataType:       utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlPathMatchConditionParameters"),
+				Operator:        cdn.URLPathOperator(condition["operator"].(string)),
+				NegateCondition: utils.Bool(condition["negate_condition"].(bool)),
+				MatchValues:     utils.ExpandStringArray(condition["match_values"].([]interface{})),
+				Transforms:      expandArmCdnEndpointDeliveryRuleConditionTransforms(condition["transforms"].([]interface{})),
+			},
+		})
+	}
+	for _, v := range input["url_file_extension_condition"].([]interface{}) {
+		condition := v.(map[string]interface{})
+		conditions = append(conditions, cdn.DeliveryRuleURLFileExtensionCondition{
+			Name: cdn.NameURLFileExtension,
+			Parameters: &cdn.URLFileExtensionMatchConditionParameters{
+				OdataType:       utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlFileExtensionMatchConditionParameters"),
+				Operator:        cdn.URLFileExtensionOperator(condition["operator"].(string)),
+				NegateCondition: utils.Bool(condition["negate_condition"].(bool)),
+				MatchValues:     utils.ExpandStringArray(condition["match_values"].([]interface{})),
+				Transforms:      expandArmCdnEndpointDeliveryRuleConditionTransforms(condition["transforms"].([]interface{})),
+			},
+		})
+	}
+	for _, v := range input["request_header_condition"].([]interface{}) {
+		condition := v.(map[string]interface{})
+		conditions = append(conditions, cdn.DeliveryRuleRequestHeaderCondition{
+			Name: cdn.NameRequestHeader,
+			Parameters: &cdn.RequestHeaderMatchConditionParameters{
+				OdataType:       utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleRequestHeaderConditionParameters"),
+				Selector:        utils.String(condition["selector"].(string)),
+				Operator:        cdn.RequestHeaderOperator(condition["operator"].(string)),
+				NegateCondition: utils.Bool(condition["negate_condition"].(bool)),
+				MatchValues:     utils.ExpandStringArray(condition["match_values"].([]interface{})),
+				Transforms:      expandArmCdnEndpointDeliveryRuleConditionTransforms(condition["transforms"].([]interface{})),
+			},
+		})
+	}
+	for _, v := range input["request_scheme_condition"].([]interface{}) {
+		condition := v.(map[string]interface{})
+		conditions = append(conditions, cdn.DeliveryRuleRequestSchemeCondition{
+			Name: cdn.NameRequestScheme,
+			Parameters: &cdn.RequestSchemeMatchConditionParameters{
+				OdataType: utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleRequestSchemeConditionParameters"),
+				// `Equal` is the only operator supported by the Request Scheme condition
+				Operator:        utils.String("Equal"),
+				NegateCondition: utils.Bool(condition["negate_condition"].(bool)),
+				MatchValues:     utils.ExpandStringArray(condition["match_values"].([]interface{})),
+			},
+		})
+	}
+
+	// the rule with order 0 is a global rule which is always applied, all others must be conditional
+	if order == 0 && len(conditions) > 0 {
+		return nil, fmt.Errorf("The `delivery_rule` with an `order` of `0` is applied to all requests and cannot specify any conditions")
+	}
+	if order > 0 && len(conditions) == 0 {
+		return nil, fmt.Errorf("The `delivery_rule` with an `order` of `%d` must specify at least one condition", order)
+	}
+
+	actions := make([]cdn.BasicDeliveryRuleAction, 0)
+	for _, v := range input["cache_expiration_action"].([]interface{}) {
+		action := v.(map[string]interface{})
+		behavior := action["behavior"].(string)
+		duration := action["duration"].(string)
+
+		parameters := &cdn.CacheExpirationActionParameters{
+			OdataType:     utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleCacheExpirationActionParameters"),
+			CacheBehavior: cdn.CacheBehavior(behavior),
+			CacheType:     utils.String("All"),
+		}
+
+		if behavior == string(cdn.BypassCache) {
+			if duration != "" {
+				return nil, fmt.Errorf("`duration` cannot be set for the `cache_expiration_action` of the `delivery_rule` with an `order` of `%d` when `behavior` is `%s`", order, behavior)
+			}
+		} else {
+			if duration == "" {
+				return nil, fmt.Errorf("`duration` must be set for the `cache_expiration_action` of the `delivery_rule` with an `order` of `%d` when `behavior` is `%s`", order, behavior)
+			}
+			parameters.CacheDuration = utils.String(duration)
+		}
+
+		actions = append(actions, cdn.DeliveryRuleCacheExpirationAction{
+			Name:       cdn.NameCacheExpiration,
+			Parameters: parameters,
+		})
+	}
+	for _, v := range input["url_redirect_action"].([]interface{}) {
+		action := v.(map[string]interface{})
+
+		parameters := &cdn.URLRedirectActionParameters{
+			OdataType:           utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlRedirectActionParameters"),
+			RedirectType:        cdn.RedirectType(action["redirect_type"].(string)),
+			DestinationProtocol: cdn.DestinationProtocol(action["protocol"].(string)),
+		}
+		if v := action["path"].(string); v != "" {
+			parameters.CustomPath = utils.String(v)
+		}
+		if v := action["hostname"].(string); v != "" {
+			parameters.CustomHostname = utils.String(v)
+		}
+		if v := action["query_string"].(string); v != "" {
+			parameters.CustomQueryString = utils.String(v)
+		}
+		if v := action["fragment"].(string); v != "" {
+			parameters.CustomFragment = utils.String(v)
+		}
+
+		actions = append(actions, cdn.URLRedirectAction{
+			Name:       cdn.NameURLRedirect,
+			Parameters: parameters,
+		})
+	}
+	for _, v := range input["request_header_action"].([]interface{}) {
+		actions = append(actions, cdn.DeliveryRuleRequestHeaderAction{
+			Name:       cdn.NameModifyRequestHeader,
+			Parameters: expandArmCdnEndpointDeliveryRuleHeaderActionParameters(v.(map[string]interface{})),
+		})
+	}
+	for _, v := range input["response_header_action"].([]interface{}) {
+		actions = append(actions, cdn.DeliveryRuleResponseHeaderAction{
+			Name:       cdn.NameModifyResponseHeader,
+			Parameters: expandArmCdnEndpointDeliveryRuleHeaderActionParameters(v.(map[string]interface{})),
+		})
+	}
+
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("The `delivery_rule` with an `order` of `%d` must specify at least one action", order)
+	}
+
+	return &cdn.DeliveryRule{
+		Order:      utils.Int32(int32(order)),
+		Conditions: &conditions,
+		Actions:    &actions,
+	}, nil
+}
+
+func expandArmCdnEndpointDeliveryRuleConditionTransforms(input []interface{}) *[]cdn.Transform {
+	transforms := make([]cdn.Transform, 0)
+	for _, v := range input {
+		transforms = append(transforms, cdn.Transform(v.(string)))
+	}
+	return &transforms
+}
+
+func expandArmCdnEndpointDeliveryRuleHeaderActionParameters(input map[string]interface{}) *cdn.HeaderActionParameters {
+	parameters := &cdn.HeaderActionParameters{
+		OdataType:    utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleHeaderActionParameters"),
+		HeaderAction: cdn.HeaderAction(input["action"].(string)),
+		HeaderName:   utils.String(input["name"].(string)),
+	}
+	if v := input["value"].(string); v != "" {
+		parameters.Value = utils.String(v)
+	}
+
+	return parameters
+}
+
+func flattenArmCdnEndpointDeliveryPolicy(input *cdn.EndpointPropertiesUpdateParametersDeliveryPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return results
+	}
+
+	for _, rule := range *input.Rules {
+		order := 0
+		if rule.Order != nil {
+			order = int(*rule.Order)
+		}
+
+		urlPathConditions := make([]interface{}, 0)
+		urlFileExtensionConditions := make([]interface{}, 0)
+		requestHeaderConditions := make([]interface{}, 0)
+		requestSchemeConditions := make([]interface{}, 0)
+		if rule.Conditions != nil {
+			for _, condition := range *rule.Conditions {
+				if v, ok := condition.AsDeliveryRuleURLPathCondition(); ok && v.Parameters != nil {
+					params := v.Parameters
+					urlPathConditions = append(urlPathConditions, flattenArmCdnEndpointDeliveryRuleConditionParameters(string(params.Operator), params.MatchValues, params.NegateCondition, params.Transforms))
+					continue
+				}
+
+				if v, ok := condition.AsDeliveryRuleURLFileExtensionCondition(); ok && v.Parameters != nil {
+					params := v.Parameters
+					urlFileExtensionConditions = append(urlFileExtensionConditions, flattenArmCdnEndpointDeliveryRuleConditionParameters(string(params.Operator), params.MatchValues, params.NegateCondition, params.Transforms))
+					continue
+				}
+
+				if v, ok := condition.AsDeliveryRuleRequestHeaderCondition(); ok && v.Parameters != nil {
+					params := v.Parameters
+					flattened := flattenArmCdnEndpointDeliveryRuleConditionParameters(string(params.Operator), params.MatchValues, params.NegateCondition, params.Transforms)
+					flattened["selector"] = flattenArmCdnEndpointOptionalString(params.Selector)
+					requestHeaderConditions = append(requestHeaderConditions, flattened)
+					continue
+				}
+
+				if v, ok := condition.AsDeliveryRuleRequestSchemeCondition(); ok && v.Parameters != nil {
+					negateCondition := false
+					if v.Parameters.NegateCondition != nil {
+						negateCondition = *v.Parameters.NegateCondition
+					}
+
+					requestSchemeConditions = append(requestSchemeConditions, map[string]interface{}{
+						"match_values":     utils.FlattenStringArray(v.Parameters.MatchValues),
+						"negate_condition": negateCondition,
+					})
+					continue
+				}
+
+				log.Printf("[DEBUG] Skipping unsupported Delivery Rule Condition")
+			}
+		}
+
+		cacheExpirationActions := make([]interface{}, 0)
+		urlRedirectActions := make([]interface{}, 0)
+		requestHeaderActions := make([]interface{}, 0)
+		responseHeaderActions := make([]interface{}, 0)
+		if rule.Actions != nil {
+			for _, action := range *rule.Actions {
+				if v, ok := action.AsDeliveryRuleCacheExpirationAction(); ok && v.Parameters != nil {
+					cacheExpirationActions = append(cacheExpirationActions, map[string]interface{}{
+						"behavior": string(v.Parameters.CacheBehavior),
+						"duration": flattenArmCdnEndpointOptionalString(v.Parameters.CacheDuration),
+					})
+					continue
+				}
+
+				if v, ok := action.AsURLRedirectAction(); ok && v.Parameters != nil {
+					params := v.Parameters
+					urlRedirectActions = append(urlRedirectActions, map[string]interface{}{
+						"redirect_type": string(params.RedirectType),
+						"protocol":      string(params.DestinationProtocol),
+						"path":          flattenArmCdnEndpointOptionalString(params.CustomPath),
+						"hostname":      flattenArmCdnEndpointOptionalString(params.CustomHostname),
+						"query_string":  flattenArmCdnEndpointOptionalString(params.CustomQueryString),
+						"fragment":      flattenArmCdnEndpointOptionalString(params.CustomFragment),
+					})
+					continue
+				}
+
+				if v, ok := action.AsDeliveryRuleRequestHeaderAction(); ok && v.Parameters != nil {
+					requestHeaderActions = append(requestHeaderActions, flattenArmCdnEndpointDeliveryRuleHeaderAction(v.Parameters))
+					continue
+				}
+
+				if v, ok := action.AsDeliveryRuleResponseHeaderAction(); ok && v.Parameters != nil {
+					responseHeaderActions = append(responseHeaderActions, flattenArmCdnEndpointDeliveryRuleHeaderAction(v.Parameters))
+					continue
+				}
+
+				log.Printf("[DEBUG] Skipping unsupported Delivery Rule Action")
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"order":                        order,
+			"url_path_condition":           urlPathConditions,
+			"url_file_extension_condition": urlFileExtensionConditions,
+			"request_header_condition":     requestHeaderConditions,
+			"request_scheme_condition":     requestSchemeConditions,
+			"cache_expiration_action":      cacheExpirationActions,
+			"url_redirect_action":          urlRedirectActions,
+			"request_header_action":        requestHeaderActions,
+			"response_header_action":       responseHeaderActions,
+		})
+	}
+
+	return results
+}
+
+func flattenArmCdnEndpointDeliveryRuleConditionParameters(operator string, matchValues *[]string, negate *bool, input *[]cdn.Transform) map[string]interface{} {
+	negateCondition := false
+	if negate != nil {
+		negateCondition = *negate
+	}
+
+	transforms := make([]interface{}, 0)
+	if input != nil {
+		for _, transform := range *input {
+			transforms = append(transforms, string(transform))
+		}
+	}
+
+	return map[string]interface{}{
+		"operator":         operator,
+		"match_values":     utils.FlattenStringArray(matchValues),
+		"negate_condition": negateCondition,
+		"transforms":       transforms,
+	}
+}
+
+func flattenArmCdnEndpointDeliveryRuleHeaderAction(input *cdn.HeaderActionParameters) map[string]interface{} {
+	return map[string]interface{}{
+		"action": string(input.HeaderAction),
+		"name":   flattenArmCdnEndpointOptionalString(input.HeaderName),
+		"value":  flattenArmCdnEndpointOptionalString(input.Value),
+	}
+}
+
+func flattenArmCdnEndpointOptionalString(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
+
+func validateCdnEndpointCacheDuration(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^([0-9]+\.)?([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `[d.]hh:mm:ss`, got %q", k, v))
+		return
+	}
+
+	if strings.Trim(strings.Replace(v, ".", ":", -1), "0:") == "" {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero, got %q", k, v))
+	}
+
+	return
+}
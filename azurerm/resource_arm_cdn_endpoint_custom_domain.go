@@ -0,0 +1,421 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2019-04-15/cdn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCdnEndpointCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCdnEndpointCustomDomainCreate,
+		Read:   resourceArmCdnEndpointCustomDomainRead,
+		Update: resourceArmCdnEndpointCustomDomainUpdate,
+		Delete: resourceArmCdnEndpointCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"host_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"custom_https_provisioning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"key_vault_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.KeyVault", "vaults"),
+						},
+
+						"secret_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"secret_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"custom_https_provisioning_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"custom_https_provisioning_substate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmCdnEndpointCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure ARM CDN Endpoint Custom Domain creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+
+	if err := validateArmCdnEndpointCustomDomainHttps(d); err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %s", name, endpointName, profileName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_cdn_endpoint_custom_domain", *existing.ID)
+		}
+	}
+
+	parameters := cdn.CustomDomainParameters{
+		CustomDomainPropertiesParameters: &cdn.CustomDomainPropertiesParameters{
+			HostName: utils.String(d.Get("host_name").(string)),
+		},
+	}
+
+	future, err := client.Create(ctx, resourceGroup, profileName, endpointName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) to finish creating: %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	d.SetId(*read.ID)
+
+	if d.Get("custom_https_provisioning_enabled").(bool) {
+		certificate := d.Get("key_vault_certificate").([]interface{})
+		if err := resourceArmCdnEndpointCustomDomainEnableCustomHttps(ctx, meta, resourceGroup, profileName, endpointName, name, certificate); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+
+	if err := validateArmCdnEndpointCustomDomainHttps(d); err != nil {
+		return err
+	}
+
+	enabled := d.Get("custom_https_provisioning_enabled").(bool)
+	certificate := d.Get("key_vault_certificate").([]interface{})
+
+	if d.HasChange("custom_https_provisioning_enabled") {
+		if enabled {
+			if err := resourceArmCdnEndpointCustomDomainEnableCustomHttps(ctx, meta, resourceGroup, profileName, endpointName, name, certificate); err != nil {
+				return err
+			}
+		} else {
+			if err := resourceArmCdnEndpointCustomDomainDisableCustomHttps(ctx, client, resourceGroup, profileName, endpointName, name); err != nil {
+				return err
+			}
+		}
+	} else if enabled && d.HasChange("key_vault_certificate") {
+		// the Certificate can't be changed whilst Custom HTTPS is enabled, so it has to be disabled and re-enabled
+		if err := resourceArmCdnEndpointCustomDomainDisableCustomHttps(ctx, client, resourceGroup, profileName, endpointName, name); err != nil {
+			return err
+		}
+
+		if err := resourceArmCdnEndpointCustomDomainEnableCustomHttps(ctx, meta, resourceGroup, profileName, endpointName, name, certificate); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+	if name == "" {
+		name = id.Path["customdomains"]
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] CDN Endpoint Custom Domain %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("profile_name", profileName)
+	d.Set("endpoint_name", endpointName)
+
+	if props := resp.CustomDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+		d.Set("custom_https_provisioning_enabled", props.CustomHTTPSProvisioningState == cdn.Enabled || props.CustomHTTPSProvisioningState == cdn.Enabling)
+		d.Set("custom_https_provisioning_state", string(props.CustomHTTPSProvisioningState))
+		d.Set("custom_https_provisioning_substate", string(props.CustomHTTPSProvisioningSubstate))
+
+		if err := d.Set("key_vault_certificate", flattenArmCdnEndpointCustomDomainKeyVaultCertificate(props.CustomHTTPSParameters)); err != nil {
+			return fmt.Errorf("Error setting `key_vault_certificate`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmCdnEndpointCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+	if name == "" {
+		name = id.Path["customdomains"]
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) to be deleted: %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateArmCdnEndpointCustomDomainHttps(d *schema.ResourceData) error {
+	if len(d.Get("key_vault_certificate").([]interface{})) > 0 && !d.Get("custom_https_provisioning_enabled").(bool) {
+		return fmt.Errorf("`key_vault_certificate` can only be specified when `custom_https_provisioning_enabled` is `true`")
+	}
+
+	return nil
+}
+
+func resourceArmCdnEndpointCustomDomainEnableCustomHttps(ctx context.Context, meta interface{}, resourceGroup, profileName, endpointName, name string, certificate []interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+
+	parameters, err := expandArmCdnEndpointCustomDomainHttpsParameters(certificate)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Enabling Custom HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+	if _, err := client.EnableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name, &parameters); err != nil {
+		return fmt.Errorf("Error enabling Custom HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	// the state remains `Disabled` briefly until the request has been picked up
+	pending := []string{string(cdn.Disabled), string(cdn.Enabling)}
+	target := []string{string(cdn.Enabled)}
+	return waitForArmCdnEndpointCustomDomainCustomHttps(ctx, client, resourceGroup, profileName, endpointName, name, pending, target)
+}
+
+func resourceArmCdnEndpointCustomDomainDisableCustomHttps(ctx context.Context, client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string) error {
+	log.Printf("[DEBUG] Disabling Custom HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+	if _, err := client.DisableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name); err != nil {
+		return fmt.Errorf("Error disabling Custom HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	pending := []string{string(cdn.Enabled), string(cdn.Disabling)}
+	target := []string{string(cdn.Disabled)}
+	return waitForArmCdnEndpointCustomDomainCustomHttps(ctx, client, resourceGroup, profileName, endpointName, name, pending, target)
+}
+
+func waitForArmCdnEndpointCustomDomainCustomHttps(ctx context.Context, client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string, pending, target []string) error {
+	// issuing and deploying a CDN managed certificate can take several hours
+	stateConf := &resource.StateChangeConf{
+		Pending:      pending,
+		Target:       target,
+		Refresh:      cdnEndpointCustomDomainCustomHttpsRefreshFunc(ctx, client, resourceGroup, profileName, endpointName, name),
+		Timeout:      8 * time.Hour,
+		PollInterval: 30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Custom HTTPS provisioning of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmCdnEndpointCustomDomainHttpsParameters(input []interface{}) (cdn.BasicCustomDomainHTTPSParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return cdn.ManagedHTTPSParameters{
+			CertificateSource: cdn.CertificateSourceCdn,
+			ProtocolType:      cdn.ServerNameIndication,
+			CertificateSourceParameters: &cdn.CertificateSourceParameters{
+				OdataType:       utils.String("#Microsoft.Azure.Cdn.Models.CdnCertificateSourceParameters"),
+				CertificateType: cdn.Dedicated,
+			},
+		}, nil
+	}
+
+	certificate := input[0].(map[string]interface{})
+	keyVaultId := certificate["key_vault_id"].(string)
+	id, err := parseAzureResourceID(keyVaultId)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Key Vault ID %q: %+v", keyVaultId, err)
+	}
+
+	return cdn.UserManagedHTTPSParameters{
+		CertificateSource: cdn.CertificateSourceAzureKeyVault,
+		ProtocolType:      cdn.ServerNameIndication,
+		CertificateSourceParameters: &cdn.KeyVaultCertificateSourceParameters{
+			OdataType:         utils.String("#Microsoft.Azure.Cdn.Models.KeyVaultCertificateSourceParameters"),
+			SubscriptionID:    utils.String(id.SubscriptionID),
+			ResourceGroupName: utils.String(id.ResourceGroup),
+			VaultName:         utils.String(id.Path["vaults"]),
+			SecretName:        utils.String(certificate["secret_name"].(string)),
+			SecretVersion:     utils.String(certificate["secret_version"].(string)),
+			UpdateRule:        utils.String("NoAction"),
+			DeleteRule:        utils.String("NoAction"),
+		},
+	}, nil
+}
+
+func flattenArmCdnEndpointCustomDomainKeyVaultCertificate(input cdn.BasicCustomDomainHTTPSParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	parameters, ok := input.AsUserManagedHTTPSParameters()
+	if !ok || parameters.CertificateSourceParameters == nil {
+		return []interface{}{}
+	}
+
+	source := parameters.CertificateSourceParameters
+	subscriptionId := flattenArmCdnEndpointOptionalString(source.SubscriptionID)
+	resourceGroup := flattenArmCdnEndpointOptionalString(source.ResourceGroupName)
+	vaultName := flattenArmCdnEndpointOptionalString(source.VaultName)
+	keyVaultId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s", subscriptionId, resourceGroup, vaultName)
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_id":   keyVaultId,
+			"secret_name":    flattenArmCdnEndpointOptionalString(source.SecretName),
+			"secret_version": flattenArmCdnEndpointOptionalString(source.SecretVersion),
+		},
+	}
+}
+
+func cdnEndpointCustomDomainCustomHttpsRefreshFunc(ctx context.Context, client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error polling for the Custom HTTPS state of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+
+		props := resp.CustomDomainProperties
+		if props == nil {
+			return nil, "", fmt.Errorf("Error polling for the Custom HTTPS state of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): `properties` was nil", name, endpointName, profileName, resourceGroup)
+		}
+
+		switch props.CustomHTTPSProvisioningSubstate {
+		case cdn.DomainControlValidationRequestRejected, cdn.DomainControlValidationRequestTimedOut:
+			return nil, "", fmt.Errorf("Domain Control Validation for CDN Endpoint Custom Domain %q failed with %q", name, string(props.CustomHTTPSProvisioningSubstate))
+		}
+
+		log.Printf("[DEBUG] Custom HTTPS state for CDN Endpoint Custom Domain %q is %q (%q)", name, string(props.CustomHTTPSProvisioningState), string(props.CustomHTTPSProvisioningSubstate))
+		return resp, string(props.CustomHTTPSProvisioningState), nil
+	}
+}
@@ -0,0 +1,356 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Custom Domains need a CNAME record pointing to the CDN Endpoint, so these tests
+// require an existing DNS Zone which has been delegated to Azure DNS
+func testAccAzureRMCdnEndpointCustomDomainPreCheck(t *testing.T) (string, string) {
+	zoneName := os.Getenv("ARM_TEST_DNS_ZONE_NAME")
+	zoneResourceGroup := os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME")
+	if zoneName == "" || zoneResourceGroup == "" {
+		t.Skip("Skipping since `ARM_TEST_DNS_ZONE_NAME` and `ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME` aren't set")
+	}
+
+	return zoneName, zoneResourceGroup
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_basic(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	config := testAccAzureRMCdnEndpointCustomDomain_basic(ri, testLocation(), zoneName, zoneResourceGroup)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCdnEndpointCustomDomain_requiresImport(ri, location, zoneName, zoneResourceGroup),
+				ExpectError: testRequiresImportError("azurerm_cdn_endpoint_custom_domain"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_customHttps(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_customHttps(ri, location, zoneName, zoneResourceGroup, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_state", "Enabled"),
+				),
+			},
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_customHttps(ri, location, zoneName, zoneResourceGroup, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+
+	// CDN only accepts Certificates issued by a supported CA, so this has to be an existing Certificate for the DNS Zone
+	keyVaultId := os.Getenv("ARM_TEST_CDN_KEY_VAULT_ID")
+	secretName := os.Getenv("ARM_TEST_CDN_KEY_VAULT_SECRET_NAME")
+	secretVersion := os.Getenv("ARM_TEST_CDN_KEY_VAULT_SECRET_VERSION")
+	if keyVaultId == "" || secretName == "" || secretVersion == "" {
+		t.Skip("Skipping since `ARM_TEST_CDN_KEY_VAULT_ID`, `ARM_TEST_CDN_KEY_VAULT_SECRET_NAME` and `ARM_TEST_CDN_KEY_VAULT_SECRET_VERSION` aren't set")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(ri, location, zoneName, zoneResourceGroup, keyVaultId, secretName, secretVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_https_provisioning_state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_certificate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_certificate.0.secret_name", secretName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandArmCdnEndpointCustomDomainHttpsParameters(t *testing.T) {
+	keyVaultId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"
+
+	cdnManaged, err := expandArmCdnEndpointCustomDomainHttpsParameters([]interface{}{})
+	if err != nil {
+		t.Fatalf("Error expanding a CDN Managed Certificate: %+v", err)
+	}
+	if _, ok := cdnManaged.AsManagedHTTPSParameters(); !ok {
+		t.Fatalf("Expected CDN Managed HTTPS Parameters but got %+v", cdnManaged)
+	}
+	if certificate := flattenArmCdnEndpointCustomDomainKeyVaultCertificate(cdnManaged); len(certificate) != 0 {
+		t.Fatalf("Expected no `key_vault_certificate` for a CDN Managed Certificate but got %+v", certificate)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"key_vault_id":   keyVaultId,
+			"secret_name":    "certificate1",
+			"secret_version": "00000000000000000000000000000000",
+		},
+	}
+	keyVault, err := expandArmCdnEndpointCustomDomainHttpsParameters(input)
+	if err != nil {
+		t.Fatalf("Error expanding a Key Vault Certificate: %+v", err)
+	}
+	userManaged, ok := keyVault.AsUserManagedHTTPSParameters()
+	if !ok {
+		t.Fatalf("Expected User Managed HTTPS Parameters but got %+v", keyVault)
+	}
+	source := userManaged.CertificateSourceParameters
+	if *source.SubscriptionID != "00000000-0000-0000-0000-000000000000" || *source.ResourceGroupName != "group1" || *source.VaultName != "vault1" {
+		t.Fatalf("Expected the Key Vault ID to be split into its components but got %+v", source)
+	}
+
+	flattened := flattenArmCdnEndpointCustomDomainKeyVaultCertificate(keyVault)
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 `key_vault_certificate` but got %d", len(flattened))
+	}
+	certificate := flattened[0].(map[string]interface{})
+	for k, v := range input[0].(map[string]interface{}) {
+		if certificate[k] != v {
+			t.Fatalf("Expected %q to be %q but got %q", k, v, certificate[k])
+		}
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) does not exist", name, endpointName, profileName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on cdnCustomDomainsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cdn_endpoint_custom_domain" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("CDN Endpoint Custom Domain still exists:\n%#v", resp.CustomDomainProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_template(rInt int, location, zoneName, zoneResourceGroup string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name      = "acceptanceTestCdnOrigin1"
+    host_name = "www.example.com"
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctestcdn%d"
+  zone_name           = "%s"
+  resource_group_name = "%s"
+  ttl                 = 300
+  record              = "${azurerm_cdn_endpoint.test.host_name}"
+}
+`, rInt, location, rInt, rInt, rInt, zoneName, zoneResourceGroup)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_basic(rInt int, location, zoneName, zoneResourceGroup string) string {
+	template := testAccAzureRMCdnEndpointCustomDomain_template(rInt, location, zoneName, zoneResourceGroup)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                = "acctestcustomdomain%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  endpoint_name       = "${azurerm_cdn_endpoint.test.name}"
+  host_name           = "${azurerm_dns_cname_record.test.name}.%s"
+}
+`, template, rInt, zoneName)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_requiresImport(rInt int, location, zoneName, zoneResourceGroup string) string {
+	template := testAccAzureRMCdnEndpointCustomDomain_basic(rInt, location, zoneName, zoneResourceGroup)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "import" {
+  name                = "${azurerm_cdn_endpoint_custom_domain.test.name}"
+  resource_group_name = "${azurerm_cdn_endpoint_custom_domain.test.resource_group_name}"
+  profile_name        = "${azurerm_cdn_endpoint_custom_domain.test.profile_name}"
+  endpoint_name       = "${azurerm_cdn_endpoint_custom_domain.test.endpoint_name}"
+  host_name           = "${azurerm_cdn_endpoint_custom_domain.test.host_name}"
+}
+`, template)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_customHttps(rInt int, location, zoneName, zoneResourceGroup string, enabled bool) string {
+	template := testAccAzureRMCdnEndpointCustomDomain_template(rInt, location, zoneName, zoneResourceGroup)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                              = "acctestcustomdomain%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  profile_name                      = "${azurerm_cdn_profile.test.name}"
+  endpoint_name                     = "${azurerm_cdn_endpoint.test.name}"
+  host_name                         = "${azurerm_dns_cname_record.test.name}.%s"
+  custom_https_provisioning_enabled = %t
+}
+`, template, rInt, zoneName, enabled)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_keyVaultCertificate(rInt int, location, zoneName, zoneResourceGroup, keyVaultId, secretName, secretVersion string) string {
+	template := testAccAzureRMCdnEndpointCustomDomain_template(rInt, location, zoneName, zoneResourceGroup)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                              = "acctestcustomdomain%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  profile_name                      = "${azurerm_cdn_profile.test.name}"
+  endpoint_name                     = "${azurerm_cdn_endpoint.test.name}"
+  host_name                         = "${azurerm_dns_cname_record.test.name}.%s"
+  custom_https_provisioning_enabled = true
+
+  key_vault_certificate {
+    key_vault_id   = "%s"
+    secret_name    = "%s"
+    secret_version = "%s"
+  }
+}
+`, template, rInt, zoneName, keyVaultId, secretName, secretVersion)
+}
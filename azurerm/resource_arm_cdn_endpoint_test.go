@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2019-04-15/cdn"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -199,6 +201,201 @@ func TestAccAzureRMCdnEndpoint_withGeoFilters(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAzureRMCdnEndpoint_deliveryRules(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpoint_deliveryRules(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.cache_expiration_action.0.behavior", "Override"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.1.url_path_condition.0.operator", "Wildcard"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.2.url_file_extension_condition.0.match_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.3.request_scheme_condition.0.match_values.0", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.3.url_redirect_action.0.protocol", "Https"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.4.request_header_condition.0.selector", "X-Example"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.4.request_header_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.4.response_header_action.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCdnEndpoint_deliveryRulesRemoved(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandArmCdnEndpointDeliveryRule(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Input       map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "Global Rule",
+			Input: testCdnEndpointDeliveryRule(0, map[string]interface{}{
+				"cache_expiration_action": []interface{}{
+					map[string]interface{}{"behavior": "Override", "duration": "1.00:00:00"},
+				},
+			}),
+			ExpectError: false,
+		},
+		{
+			Name: "Global Rule with Condition",
+			Input: testCdnEndpointDeliveryRule(0, map[string]interface{}{
+				"request_scheme_condition": []interface{}{
+					map[string]interface{}{"match_values": []interface{}{"HTTP"}, "negate_condition": false},
+				},
+				"cache_expiration_action": []interface{}{
+					map[string]interface{}{"behavior": "BypassCache", "duration": ""},
+				},
+			}),
+			ExpectError: true,
+		},
+		{
+			Name: "Conditional Rule without Condition",
+			Input: testCdnEndpointDeliveryRule(1, map[string]interface{}{
+				"cache_expiration_action": []interface{}{
+					map[string]interface{}{"behavior": "BypassCache", "duration": ""},
+				},
+			}),
+			ExpectError: true,
+		},
+		{
+			Name: "Conditional Rule without Action",
+			Input: testCdnEndpointDeliveryRule(1, map[string]interface{}{
+				"request_scheme_condition": []interface{}{
+					map[string]interface{}{"match_values": []interface{}{"HTTP"}, "negate_condition": false},
+				},
+			}),
+			ExpectError: true,
+		},
+		{
+			Name: "Conditional Rule",
+			Input: testCdnEndpointDeliveryRule(1, map[string]interface{}{
+				"request_header_condition": []interface{}{
+					map[string]interface{}{
+						"selector":         "X-Example",
+						"operator":         "Equal",
+						"match_values":     []interface{}{"example"},
+						"negate_condition": true,
+						"transforms":       []interface{}{"Lowercase"},
+					},
+				},
+				"request_scheme_condition": []interface{}{
+					map[string]interface{}{"match_values": []interface{}{"HTTP"}, "negate_condition": false},
+				},
+				"url_redirect_action": []interface{}{
+					map[string]interface{}{
+						"redirect_type": "Found",
+						"protocol":      "Https",
+						"path":          "/example",
+						"hostname":      "",
+						"query_string":  "",
+						"fragment":      "",
+					},
+				},
+				"response_header_action": []interface{}{
+					map[string]interface{}{"action": "Overwrite", "name": "X-Example", "value": "example"},
+				},
+			}),
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		rule, err := expandArmCdnEndpointDeliveryRule(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for %q: %+v", tc.Name, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Name)
+		}
+
+		// the expanded rule should flatten back to the same configuration
+		rules := []cdn.DeliveryRule{*rule}
+		flattened := flattenArmCdnEndpointDeliveryPolicy(&cdn.EndpointPropertiesUpdateParametersDeliveryPolicy{Rules: &rules})
+		if len(flattened) != 1 {
+			t.Fatalf("Expected 1 rule for %q but got %d", tc.Name, len(flattened))
+		}
+
+		if !reflect.DeepEqual(flattened[0], tc.Input) {
+			t.Fatalf("Expected %q to flatten to:\n%+v\n\nbut got:\n%+v", tc.Name, tc.Input, flattened[0])
+		}
+	}
+}
+
+func testCdnEndpointDeliveryRule(order int, values map[string]interface{}) map[string]interface{} {
+	rule := map[string]interface{}{
+		"order":                        order,
+		"url_path_condition":           []interface{}{},
+		"url_file_extension_condition": []interface{}{},
+		"request_header_condition":     []interface{}{},
+		"request_scheme_condition":     []interface{}{},
+		"cache_expiration_action":      []interface{}{},
+		"url_redirect_action":          []interface{}{},
+		"request_header_action":        []interface{}{},
+		"response_header_action":       []interface{}{},
+	}
+
+	for k, v := range values {
+		rule[k] = v
+	}
+
+	return rule
+}
+
+func TestValidateCdnEndpointCacheDuration(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{Value: "", Errors: 1},
+		{Value: "00:00:00", Errors: 1},
+		{Value: "0.00:00:00", Errors: 1},
+		{Value: "1:00:00", Errors: 1},
+		{Value: "24:00:00", Errors: 1},
+		{Value: "00:60:00", Errors: 1},
+		{Value: "1.", Errors: 1},
+		{Value: "00:00:01", Errors: 0},
+		{Value: "12:30:00", Errors: 0},
+		{Value: "1.00:00:00", Errors: 0},
+		{Value: "365.23:59:59", Errors: 0},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCdnEndpointCacheDuration(tc.Value, "duration")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected validateCdnEndpointCacheDuration to return %d errors for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestAccAzureRMCdnEndpoint_fullFields(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
 	ri := tf.AccRandTimeInt()
@@ -666,3 +863,140 @@ resource "azurerm_cdn_endpoint" "test" {
 }
 `, rInt, location, rInt, rInt, isHttpAllowed, isHttpsAllowed)
 }
+
+func testAccAzureRMCdnEndpoint_deliveryRules(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  delivery_rule {
+    order = 0
+
+    cache_expiration_action {
+      behavior = "Override"
+      duration = "1.00:00:00"
+    }
+  }
+
+  delivery_rule {
+    order = 1
+
+    url_path_condition {
+      operator     = "Wildcard"
+      match_values = ["/api/*"]
+    }
+
+    cache_expiration_action {
+      behavior = "BypassCache"
+    }
+  }
+
+  delivery_rule {
+    order = 2
+
+    url_file_extension_condition {
+      operator     = "Equal"
+      match_values = ["css", "js"]
+      transforms   = ["Lowercase"]
+    }
+
+    cache_expiration_action {
+      behavior = "SetIfMissing"
+      duration = "12:00:00"
+    }
+  }
+
+  delivery_rule {
+    order = 3
+
+    request_scheme_condition {
+      match_values = ["HTTP"]
+    }
+
+    url_redirect_action {
+      redirect_type = "PermanentRedirect"
+      protocol      = "Https"
+    }
+  }
+
+  delivery_rule {
+    order = 4
+
+    request_header_condition {
+      selector         = "X-Example"
+      operator         = "Any"
+      negate_condition = true
+    }
+
+    request_header_action {
+      action = "Overwrite"
+      name   = "X-Forwarded-By"
+      value  = "cdn"
+    }
+
+    response_header_action {
+      action = "Append"
+      name   = "Cache-Control"
+      value  = "public"
+    }
+
+    response_header_action {
+      action = "Delete"
+      name   = "Server"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMCdnEndpoint_deliveryRulesRemoved(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -13,8 +13,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-type cognitiveServicesPropertiesStruct struct{}
-
 func resourceArmCognitiveAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmCognitiveAccountCreate,
@@ -141,11 +139,11 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 	tags := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
-	properties := cognitiveservices.AccountCreateParameters{
+	properties := cognitiveservices.Account{
 		Kind:       utils.String(kind),
 		Location:   utils.String(location),
 		Sku:        sku,
-		Properties: &cognitiveServicesPropertiesStruct{},
+		Properties: &cognitiveservices.AccountProperties{},
 		Tags:       expandTags(tags),
 	}
 
@@ -178,7 +176,7 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 	tags := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
-	properties := cognitiveservices.AccountUpdateParameters{
+	properties := cognitiveservices.Account{
 		Sku:  sku,
 		Tags: expandTags(tags),
 	}
@@ -226,7 +224,7 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
-	if props := resp.AccountProperties; props != nil {
+	if props := resp.Properties; props != nil {
 		d.Set("endpoint", props.Endpoint)
 	}
 
@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -35,10 +36,18 @@ func resourceArmDnsARecord() *schema.Resource {
 			},
 
 			"records": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"target_resource_id"},
+			},
+
+			"target_resource_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"records"},
 			},
 
 			"ttl": {
@@ -74,16 +83,28 @@ func resourceArmDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	ttl := int64(d.Get("ttl").(int))
 	tags := d.Get("tags").(map[string]interface{})
+	targetResourceId := d.Get("target_resource_id").(string)
+	records := d.Get("records").(*schema.Set).List()
+
+	if targetResourceId == "" && len(records) == 0 {
+		return fmt.Errorf("One of either `records` or `target_resource_id` must be specified")
+	}
 
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
 			Metadata: expandTags(tags),
 			TTL:      &ttl,
-			ARecords: expandAzureRmDnsARecords(d),
+			ARecords: expandAzureRmDnsARecords(records),
+			// an empty Target Resource is sent so that an alias is removed when switching to `records`
+			TargetResource: &dns.SubResource{},
 		},
 	}
 
+	if targetResourceId != "" {
+		parameters.RecordSetProperties.TargetResource.ID = utils.String(targetResourceId)
+	}
+
 	eTag := ""
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.A, parameters, eTag, ifNoneMatch); err != nil {
@@ -134,6 +155,13 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("records", flattenAzureRmDnsARecords(resp.ARecords)); err != nil {
 		return err
 	}
+
+	targetResourceId := ""
+	if resp.TargetResource != nil && resp.TargetResource.ID != nil {
+		targetResourceId = *resp.TargetResource.ID
+	}
+	d.Set("target_resource_id", targetResourceId)
+
 	flattenAndSetTags(d, resp.Metadata)
 
 	return nil
@@ -161,10 +189,14 @@ func resourceArmDnsARecordDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func flattenAzureRmDnsARecords(records *[]dns.ARecord) []string {
-	results := make([]string, 0, len(*records))
+	results := make([]string, 0)
 
 	if records != nil {
 		for _, record := range *records {
+			if record.Ipv4Address == nil {
+				continue
+			}
+
 			results = append(results, *record.Ipv4Address)
 		}
 	}
@@ -172,8 +204,7 @@ func flattenAzureRmDnsARecords(records *[]dns.ARecord) []string {
 	return results
 }
 
-func expandAzureRmDnsARecords(d *schema.ResourceData) *[]dns.ARecord {
-	recordStrings := d.Get("records").(*schema.Set).List()
+func expandAzureRmDnsARecords(recordStrings []interface{}) *[]dns.ARecord {
 	records := make([]dns.ARecord, len(recordStrings))
 
 	for i, v := range recordStrings {
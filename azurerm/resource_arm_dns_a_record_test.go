@@ -3,14 +3,56 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestFlattenAzureRMDnsARecords(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *[]dns.ARecord
+		Expected []string
+	}{
+		{
+			Name:     "Nil Records",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "Empty Records",
+			Input:    &[]dns.ARecord{},
+			Expected: []string{},
+		},
+		{
+			Name: "Records with a nil Address",
+			Input: &[]dns.ARecord{
+				{Ipv4Address: utils.String("10.0.0.1")},
+				{},
+				{Ipv4Address: utils.String("10.0.0.2")},
+			},
+			Expected: []string{"10.0.0.1", "10.0.0.2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenAzureRmDnsARecords(tc.Input)
+		if actual == nil {
+			t.Fatalf("Expected an empty list but got nil")
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMDnsARecord_basic(t *testing.T) {
 	resourceName := "azurerm_dns_a_record.test"
 	ri := tf.AccRandTimeInt()
@@ -130,6 +172,96 @@ func TestAccAzureRMDnsARecord_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDnsARecord_withAlias(t *testing.T) {
+	resourceName := "azurerm_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMDnsARecord_withAlias(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_public_ip.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsARecord_RecordsToAlias(t *testing.T) {
+	resourceName := "azurerm_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDnsARecord_basic(ri, location)
+	postConfig := testAccAzureRMDnsARecord_withAlias(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_id", ""),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_public_ip.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsARecord_AliasToRecords(t *testing.T) {
+	resourceName := "azurerm_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDnsARecord_withAlias(ri, location)
+	postConfig := testAccAzureRMDnsARecord_aliasToRecords(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_public_ip.test", "id"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDnsARecordExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -300,3 +432,63 @@ resource "azurerm_dns_a_record" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMDnsARecord_withAlias(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+  ip_version          = "IPv4"
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  target_resource_id  = "${azurerm_public_ip.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDnsARecord_aliasToRecords(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+  ip_version          = "IPv4"
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["1.2.3.4"]
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -35,10 +36,18 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 			},
 
 			"records": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"target_resource_id"},
+			},
+
+			"target_resource_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"records"},
 			},
 
 			"ttl": {
@@ -74,16 +83,28 @@ func resourceArmDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta interface
 
 	ttl := int64(d.Get("ttl").(int))
 	tags := d.Get("tags").(map[string]interface{})
+	targetResourceId := d.Get("target_resource_id").(string)
+	records := d.Get("records").(*schema.Set).List()
+
+	if targetResourceId == "" && len(records) == 0 {
+		return fmt.Errorf("One of either `records` or `target_resource_id` must be specified")
+	}
 
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
 			Metadata:    expandTags(tags),
 			TTL:         &ttl,
-			AaaaRecords: expandAzureRmDnsAaaaRecords(d),
+			AaaaRecords: expandAzureRmDnsAaaaRecords(records),
+			// an empty Target Resource is sent so that an alias is removed when switching to `records`
+			TargetResource: &dns.SubResource{},
 		},
 	}
 
+	if targetResourceId != "" {
+		parameters.RecordSetProperties.TargetResource.ID = utils.String(targetResourceId)
+	}
+
 	eTag := ""
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.AAAA, parameters, eTag, ifNoneMatch); err != nil {
@@ -134,6 +155,13 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("records", flattenAzureRmDnsAaaaRecords(resp.AaaaRecords)); err != nil {
 		return err
 	}
+
+	targetResourceId := ""
+	if resp.TargetResource != nil && resp.TargetResource.ID != nil {
+		targetResourceId = *resp.TargetResource.ID
+	}
+	d.Set("target_resource_id", targetResourceId)
+
 	flattenAndSetTags(d, resp.Metadata)
 
 	return nil
@@ -161,10 +189,14 @@ func resourceArmDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func flattenAzureRmDnsAaaaRecords(records *[]dns.AaaaRecord) []string {
-	results := make([]string, 0, len(*records))
+	results := make([]string, 0)
 
 	if records != nil {
 		for _, record := range *records {
+			if record.Ipv6Address == nil {
+				continue
+			}
+
 			results = append(results, *record.Ipv6Address)
 		}
 	}
@@ -172,8 +204,7 @@ func flattenAzureRmDnsAaaaRecords(records *[]dns.AaaaRecord) []string {
 	return results
 }
 
-func expandAzureRmDnsAaaaRecords(d *schema.ResourceData) *[]dns.AaaaRecord {
-	recordStrings := d.Get("records").(*schema.Set).List()
+func expandAzureRmDnsAaaaRecords(recordStrings []interface{}) *[]dns.AaaaRecord {
 	records := make([]dns.AaaaRecord, len(recordStrings))
 
 	for i, v := range recordStrings {
@@ -3,14 +3,56 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestFlattenAzureRMDnsAaaaRecords(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *[]dns.AaaaRecord
+		Expected []string
+	}{
+		{
+			Name:     "Nil Records",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "Empty Records",
+			Input:    &[]dns.AaaaRecord{},
+			Expected: []string{},
+		},
+		{
+			Name: "Records with a nil Address",
+			Input: &[]dns.AaaaRecord{
+				{Ipv6Address: utils.String("2607:f8b0:4009:1803::1005")},
+				{},
+				{Ipv6Address: utils.String("2607:f8b0:4009:1803::1006")},
+			},
+			Expected: []string{"2607:f8b0:4009:1803::1005", "2607:f8b0:4009:1803::1006"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenAzureRmDnsAaaaRecords(tc.Input)
+		if actual == nil {
+			t.Fatalf("Expected an empty list but got nil")
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMDnsAAAARecord_basic(t *testing.T) {
 	resourceName := "azurerm_dns_aaaa_record.test"
 	ri := tf.AccRandTimeInt()
@@ -130,6 +172,33 @@ func TestAccAzureRMDnsAAAARecord_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDnsAAAARecord_withAlias(t *testing.T) {
+	resourceName := "azurerm_dns_aaaa_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMDnsAAAARecord_withAlias(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsAaaaRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsAaaaRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_public_ip.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDnsAaaaRecordExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -300,3 +369,33 @@ resource "azurerm_dns_aaaa_record" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMDnsAAAARecord_withAlias(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+  ip_version          = "IPv6"
+}
+
+resource "azurerm_dns_aaaa_record" "test" {
+  name                = "myaaaarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  target_resource_id  = "${azurerm_public_ip.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
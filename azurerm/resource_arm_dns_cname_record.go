@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -43,8 +44,16 @@ func resourceArmDnsCNameRecord() *schema.Resource {
 			},
 
 			"record": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"target_resource_id"},
+			},
+
+			"target_resource_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"record"},
 			},
 
 			"ttl": {
@@ -81,18 +90,32 @@ func resourceArmDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta interfac
 	ttl := int64(d.Get("ttl").(int))
 	record := d.Get("record").(string)
 	tags := d.Get("tags").(map[string]interface{})
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	if targetResourceId == "" && record == "" {
+		return fmt.Errorf("One of either `record` or `target_resource_id` must be specified")
+	}
 
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
 			Metadata: expandTags(tags),
 			TTL:      &ttl,
-			CnameRecord: &dns.CnameRecord{
-				Cname: &record,
-			},
+			// an empty Target Resource is sent so that an alias is removed when switching to `record`
+			TargetResource: &dns.SubResource{},
 		},
 	}
 
+	if record != "" {
+		parameters.RecordSetProperties.CnameRecord = &dns.CnameRecord{
+			Cname: utils.String(record),
+		}
+	}
+
+	if targetResourceId != "" {
+		parameters.RecordSetProperties.TargetResource.ID = utils.String(targetResourceId)
+	}
+
 	eTag := ""
 	ifNoneMatch := "" // set to empty to allow updates to records after creation
 	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, dns.CNAME, parameters, eTag, ifNoneMatch); err != nil {
@@ -141,9 +164,17 @@ func resourceArmDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("ttl", resp.TTL)
 
 	if props := resp.RecordSetProperties; props != nil {
-		if record := props.CnameRecord; record != nil {
-			d.Set("record", record.Cname)
+		record := ""
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			record = *props.CnameRecord.Cname
+		}
+		d.Set("record", record)
+
+		targetResourceId := ""
+		if props.TargetResource != nil && props.TargetResource.ID != nil {
+			targetResourceId = *props.TargetResource.ID
 		}
+		d.Set("target_resource_id", targetResourceId)
 	}
 
 	flattenAndSetTags(d, resp.Metadata)
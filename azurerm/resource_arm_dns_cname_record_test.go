@@ -5,7 +5,7 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -154,6 +154,96 @@ func TestAccAzureRMDnsCNameRecord_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDnsCNameRecord_withAlias(t *testing.T) {
+	resourceName := "azurerm_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMDnsCNameRecord_withAlias(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_dns_cname_record.target", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsCNameRecord_RecordToAlias(t *testing.T) {
+	resourceName := "azurerm_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDnsCNameRecord_aliasToRecord(ri, location)
+	postConfig := testAccAzureRMDnsCNameRecord_withAlias(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_id", ""),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_dns_cname_record.target", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsCNameRecord_AliasToRecord(t *testing.T) {
+	resourceName := "azurerm_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	preConfig := testAccAzureRMDnsCNameRecord_withAlias(ri, location)
+	postConfig := testAccAzureRMDnsCNameRecord_aliasToRecord(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_resource_id", "azurerm_dns_cname_record.target", "id"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDnsCNameRecordExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -346,3 +436,63 @@ resource "azurerm_dns_cname_record" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMDnsCNameRecord_withAlias(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_cname_record" "target" {
+  name                = "mycnametarget%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  target_resource_id  = "${azurerm_dns_cname_record.target.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDnsCNameRecord_aliasToRecord(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_cname_record" "target" {
+  name                = "mycnametarget%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "mycnamerecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
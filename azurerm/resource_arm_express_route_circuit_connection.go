@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmExpressRouteCircuitConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmExpressRouteCircuitConnectionCreateUpdate,
+		Read:   resourceArmExpressRouteCircuitConnectionRead,
+		Update: resourceArmExpressRouteCircuitConnectionCreateUpdate,
+		Delete: resourceArmExpressRouteCircuitConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"peer_peering_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CIDR,
+			},
+
+			"authorization_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"circuit_connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmExpressRouteCircuitConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).expressRouteConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Express Route Circuit Connection create/update.")
+
+	name := d.Get("name").(string)
+	peeringId := d.Get("peering_id").(string)
+
+	id, err := parseAzureResourceID(peeringId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q): %s", name, peeringName, circuitName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_express_route_circuit_connection", *existing.ID)
+		}
+	}
+
+	parameters := network.ExpressRouteCircuitConnection{
+		ExpressRouteCircuitConnectionPropertiesFormat: &network.ExpressRouteCircuitConnectionPropertiesFormat{
+			ExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(peeringId),
+			},
+			PeerExpressRouteCircuitPeering: &network.SubResource{
+				ID: utils.String(d.Get("peer_peering_id").(string)),
+			},
+			AddressPrefix: utils.String(d.Get("address_prefix").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		parameters.ExpressRouteCircuitConnectionPropertiesFormat.AuthorizationKey = utils.String(v.(string))
+	}
+
+	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
+	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, circuitName, peeringName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q): %+v", name, peeringName, circuitName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q): %+v", name, peeringName, circuitName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		return err
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q)", name, peeringName, circuitName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmExpressRouteCircuitConnectionRead(d, meta)
+}
+
+func resourceArmExpressRouteCircuitConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).expressRouteConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+	name := id.Path["connections"]
+
+	resp, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q) was not found - removing from state", name, peeringName, circuitName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q): %+v", name, peeringName, circuitName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+
+	if props := resp.ExpressRouteCircuitConnectionPropertiesFormat; props != nil {
+		if peering := props.ExpressRouteCircuitPeering; peering != nil {
+			d.Set("peering_id", peering.ID)
+		}
+		if peer := props.PeerExpressRouteCircuitPeering; peer != nil {
+			d.Set("peer_peering_id", peer.ID)
+		}
+		d.Set("address_prefix", props.AddressPrefix)
+		d.Set("circuit_connection_status", string(props.CircuitConnectionStatus))
+	}
+
+	return nil
+}
+
+func resourceArmExpressRouteCircuitConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).expressRouteConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	circuitName := id.Path["expressRouteCircuits"]
+	peeringName := id.Path["peerings"]
+	name := id.Path["connections"]
+
+	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
+	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, circuitName, peeringName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error issuing delete request for Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q): %+v", name, peeringName, circuitName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for Connection %q (Peering %q / ExpressRoute Circuit %q / Resource Group %q) to be deleted: %+v", name, peeringName, circuitName, resourceGroup, err)
+	}
+
+	return nil
+}
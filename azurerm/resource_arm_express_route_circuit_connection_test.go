@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMExpressRouteCircuitConnection_basic(t *testing.T) {
+	resourceName := "azurerm_express_route_circuit_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_prefix", "192.169.8.0/29"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_express_route_circuit_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMExpressRouteCircuitConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMExpressRouteCircuitConnection_basicConfig(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMExpressRouteCircuitConnection_requiresImportConfig(ri, location),
+				ExpectError: testRequiresImportError("azurerm_express_route_circuit_connection"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMExpressRouteCircuitConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		circuitName := id.Path["expressRouteCircuits"]
+		peeringName := id.Path["peerings"]
+		name := id.Path["connections"]
+
+		client := testAccProvider.Meta().(*ArmClient).expressRouteConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (Peering %q / Circuit %q / Resource Group %q) does not exist", name, peeringName, circuitName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on expressRouteConnectionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMExpressRouteCircuitConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).expressRouteConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_express_route_circuit_connection" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		circuitName := id.Path["expressRouteCircuits"]
+		peeringName := id.Path["peerings"]
+		name := id.Path["connections"]
+
+		resp, err := client.Get(ctx, resourceGroup, circuitName, peeringName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Express Route Circuit Connection still exists:\n%#v", resp.ExpressRouteCircuitConnectionPropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_basicConfig(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit" "peer" {
+  name                  = "acctest-erc-peer-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  service_provider_name = "Equinix"
+  peering_location      = "Washington DC"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Premium"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit_peering" "test" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.test.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  shared_key                    = "ABCdefGHIJklm@nOPqrsTU!!"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.1.0/30"
+  secondary_peer_address_prefix = "192.168.2.0/30"
+  vlan_id                       = 100
+}
+
+resource "azurerm_express_route_circuit_peering" "peer" {
+  peering_type                  = "AzurePrivatePeering"
+  express_route_circuit_name    = "${azurerm_express_route_circuit.peer.name}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  shared_key                    = "ABCdefGHIJklm@nOPqrsTU!!"
+  peer_asn                      = 100
+  primary_peer_address_prefix   = "192.168.3.0/30"
+  secondary_peer_address_prefix = "192.168.4.0/30"
+  vlan_id                       = 100
+}
+
+resource "azurerm_express_route_circuit_connection" "test" {
+  name            = "acctest-ercon-%d"
+  peering_id      = "${azurerm_express_route_circuit_peering.test.id}"
+  peer_peering_id = "${azurerm_express_route_circuit_peering.peer.id}"
+  address_prefix  = "192.169.8.0/29"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMExpressRouteCircuitConnection_requiresImportConfig(rInt int, location string) string {
+	template := testAccAzureRMExpressRouteCircuitConnection_basicConfig(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit_connection" "import" {
+  name            = "${azurerm_express_route_circuit_connection.test.name}"
+  peering_id      = "${azurerm_express_route_circuit_connection.test.peering_id}"
+  peer_peering_id = "${azurerm_express_route_circuit_connection.test.peer_peering_id}"
+  address_prefix  = "${azurerm_express_route_circuit_connection.test.address_prefix}"
+}
+`, template)
+}
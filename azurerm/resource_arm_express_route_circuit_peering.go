@@ -5,11 +5,13 @@ import (
 	"log"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -86,6 +88,60 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 				},
 			},
 
+			"route_filter_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ipv6": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary_peer_address_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"secondary_peer_address_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"microsoft_peering": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"advertised_public_prefixes": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"route_filter_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
 			"azure_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -154,7 +210,21 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 
 		peeringConfig := expandExpressRouteCircuitPeeringMicrosoftConfig(peerings)
 		parameters.ExpressRouteCircuitPeeringPropertiesFormat.MicrosoftPeeringConfig = peeringConfig
+
+		if v := d.Get("route_filter_id").(string); v != "" {
+			parameters.ExpressRouteCircuitPeeringPropertiesFormat.RouteFilter = &network.SubResource{
+				ID: utils.String(v),
+			}
+		}
+	} else if d.Get("route_filter_id").(string) != "" {
+		return fmt.Errorf("`route_filter_id` can only be specified when `peering_type` is set to `MicrosoftPeering`")
+	}
+
+	ipv6, err := expandExpressRouteCircuitPeeringIpv6Config(d.Get("ipv6").([]interface{}), peeringType)
+	if err != nil {
+		return err
 	}
+	parameters.ExpressRouteCircuitPeeringPropertiesFormat.Ipv6PeeringConfig = ipv6
 
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
@@ -216,6 +286,16 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 		if err := d.Set("microsoft_peering_config", config); err != nil {
 			return fmt.Errorf("Error setting `microsoft_peering_config`: %+v", err)
 		}
+
+		routeFilterId := ""
+		if props.RouteFilter != nil && props.RouteFilter.ID != nil {
+			routeFilterId = *props.RouteFilter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
+
+		if err := d.Set("ipv6", flattenExpressRouteCircuitPeeringIpv6Config(props.Ipv6PeeringConfig)); err != nil {
+			return fmt.Errorf("Error setting `ipv6`: %+v", err)
+		}
 	}
 
 	return nil
@@ -284,3 +364,76 @@ func flattenExpressRouteCircuitPeeringMicrosoftConfig(input *network.ExpressRout
 
 	return []interface{}{config}
 }
+
+func expandExpressRouteCircuitPeeringIpv6Config(input []interface{}, peeringType string) (*network.Ipv6ExpressRouteCircuitPeeringConfig, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	state := network.ExpressRouteCircuitPeeringStateDisabled
+	if v["enabled"].(bool) {
+		state = network.ExpressRouteCircuitPeeringStateEnabled
+	}
+
+	config := network.Ipv6ExpressRouteCircuitPeeringConfig{
+		PrimaryPeerAddressPrefix:   utils.String(v["primary_peer_address_prefix"].(string)),
+		SecondaryPeerAddressPrefix: utils.String(v["secondary_peer_address_prefix"].(string)),
+		State:                      state,
+	}
+
+	microsoftPeering := v["microsoft_peering"].([]interface{})
+	routeFilterId := v["route_filter_id"].(string)
+	if !strings.EqualFold(peeringType, string(network.MicrosoftPeering)) {
+		if len(microsoftPeering) > 0 || routeFilterId != "" {
+			return nil, fmt.Errorf("`ipv6.0.microsoft_peering` and `ipv6.0.route_filter_id` can only be specified when `peering_type` is set to `MicrosoftPeering`")
+		}
+
+		return &config, nil
+	}
+
+	if len(microsoftPeering) == 0 {
+		return nil, fmt.Errorf("`ipv6.0.microsoft_peering` must be specified when `peering_type` is set to `MicrosoftPeering`")
+	}
+	config.MicrosoftPeeringConfig = expandExpressRouteCircuitPeeringMicrosoftConfig(microsoftPeering)
+
+	if routeFilterId != "" {
+		config.RouteFilter = &network.SubResource{
+			ID: utils.String(routeFilterId),
+		}
+	}
+
+	return &config, nil
+}
+
+func flattenExpressRouteCircuitPeeringIpv6Config(input *network.Ipv6ExpressRouteCircuitPeeringConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	primaryPeerAddressPrefix := ""
+	if input.PrimaryPeerAddressPrefix != nil {
+		primaryPeerAddressPrefix = *input.PrimaryPeerAddressPrefix
+	}
+
+	secondaryPeerAddressPrefix := ""
+	if input.SecondaryPeerAddressPrefix != nil {
+		secondaryPeerAddressPrefix = *input.SecondaryPeerAddressPrefix
+	}
+
+	routeFilterId := ""
+	if input.RouteFilter != nil && input.RouteFilter.ID != nil {
+		routeFilterId = *input.RouteFilter.ID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"primary_peer_address_prefix":   primaryPeerAddressPrefix,
+			"secondary_peer_address_prefix": secondaryPeerAddressPrefix,
+			"enabled":                       input.State == network.ExpressRouteCircuitPeeringStateEnabled,
+			"microsoft_peering":             flattenExpressRouteCircuitPeeringMicrosoftConfig(input.MicrosoftPeeringConfig),
+			"route_filter_id":               routeFilterId,
+		},
+	}
+}
@@ -0,0 +1,1121 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFrontDoor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFrontDoorCreateUpdate,
+		Read:   resourceArmFrontDoorRead,
+		Update: resourceArmFrontDoorCreateUpdate,
+		Delete: resourceArmFrontDoorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmFrontDoorCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"load_balancer_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"routing_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"frontend_endpoints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"accepted_protocols": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(frontdoor.HTTP),
+									string(frontdoor.HTTPS),
+								}, false),
+							},
+						},
+
+						"patterns_to_match": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 25,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"forwarding_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backend_pool_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"custom_forwarding_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"forwarding_protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.MatchRequest),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.HTTPOnly),
+											string(frontdoor.HTTPSOnly),
+											string(frontdoor.MatchRequest),
+										}, false),
+									},
+
+									"cache_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"cache_query_parameter_strip_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.StripNone),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.StripAll),
+											string(frontdoor.StripNone),
+										}, false),
+									},
+
+									"cache_use_dynamic_compression": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"backend_pool_load_balancing": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"sample_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"successful_samples_required": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"additional_latency_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"backend_pool_health_probe": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "/",
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(frontdoor.HTTP),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.HTTP),
+								string(frontdoor.HTTPS),
+							}, false),
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      120,
+							ValidateFunc: validation.IntAtLeast(5),
+						},
+					},
+				},
+			},
+
+			"backend_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"backend": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"http_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.PortNumber,
+									},
+
+									"https_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.PortNumber,
+									},
+
+									"host_header": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(1, 5),
+									},
+
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      50,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+
+						"load_balancing_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"health_probe_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"frontend_endpoint": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"host_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"session_affinity_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"session_affinity_ttl_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"web_application_firewall_policy_link_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceIDOrEmpty,
+						},
+
+						"custom_https_provisioning_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"custom_https_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_source": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.CertificateSourceFrontDoor),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.CertificateSourceAzureKeyVault),
+											string(frontdoor.CertificateSourceFrontDoor),
+										}, false),
+									},
+
+									"azure_key_vault_certificate_vault_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: azure.ValidateResourceIDOrEmpty,
+									},
+
+									"azure_key_vault_certificate_secret_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"azure_key_vault_certificate_secret_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"provisioning_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"provisioning_substate": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for Front Door creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_frontdoor", *existing.ID)
+		}
+	}
+
+	enabledState := frontdoor.EnabledStateEnumDisabled
+	if d.Get("load_balancer_enabled").(bool) {
+		enabledState = frontdoor.EnabledStateEnumEnabled
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := frontdoor.FrontDoor{
+		// Front Door is a global resource
+		Location: utils.String("Global"),
+		Properties: &frontdoor.Properties{
+			FriendlyName:          utils.String(d.Get("friendly_name").(string)),
+			RoutingRules:          expandArmFrontDoorRoutingRules(d.Get("routing_rule").([]interface{}), subscriptionId, resourceGroup, name),
+			BackendPools:          expandArmFrontDoorBackendPools(d.Get("backend_pool").([]interface{}), subscriptionId, resourceGroup, name),
+			FrontendEndpoints:     expandArmFrontDoorFrontendEndpoints(d.Get("frontend_endpoint").([]interface{}), subscriptionId, resourceGroup, name),
+			HealthProbeSettings:   expandArmFrontDoorHealthProbeSettings(d.Get("backend_pool_health_probe").([]interface{}), subscriptionId, resourceGroup, name),
+			LoadBalancingSettings: expandArmFrontDoorLoadBalancingSettings(d.Get("backend_pool_load_balancing").([]interface{}), subscriptionId, resourceGroup, name),
+			EnabledState:          enabledState,
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Front Door %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	// custom HTTPS isn't part of the Front Door payload and has to be toggled per Frontend Endpoint
+	for _, raw := range d.Get("frontend_endpoint").([]interface{}) {
+		endpoint := raw.(map[string]interface{})
+		if err := resourceArmFrontDoorFrontendEndpointUpdateCustomHttps(d, meta, resourceGroup, name, endpoint); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmFrontDoorRead(d, meta)
+}
+
+func resourceArmFrontDoorFrontendEndpointUpdateCustomHttps(d *schema.ResourceData, meta interface{}, resourceGroup, frontDoorName string, endpoint map[string]interface{}) error {
+	client := meta.(*ArmClient).frontDoorsFrontendClient
+	ctx := meta.(*ArmClient).StopContext
+
+	endpointName := endpoint["name"].(string)
+	enableCustomHttps := endpoint["custom_https_provisioning_enabled"].(bool)
+
+	resp, err := client.Get(ctx, resourceGroup, frontDoorName, endpointName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Frontend Endpoint %q (Front Door %q / Resource Group %q): %+v", endpointName, frontDoorName, resourceGroup, err)
+	}
+
+	provisioningState := frontdoor.Disabled
+	if props := resp.FrontendEndpointProperties; props != nil && props.CustomHTTPSProvisioningState != "" {
+		provisioningState = props.CustomHTTPSProvisioningState
+	}
+
+	if enableCustomHttps && (provisioningState == frontdoor.Disabled || provisioningState == frontdoor.Failed) {
+		log.Printf("[DEBUG] Enabling Custom HTTPS on Frontend Endpoint %q (Front Door %q / Resource Group %q)", endpointName, frontDoorName, resourceGroup)
+		config := expandArmFrontDoorCustomHTTPSConfiguration(endpoint["custom_https_configuration"].([]interface{}))
+		future, err := client.EnableHTTPS(ctx, resourceGroup, frontDoorName, endpointName, config)
+		if err != nil {
+			return fmt.Errorf("Error enabling Custom HTTPS on Frontend Endpoint %q (Front Door %q / Resource Group %q): %+v", endpointName, frontDoorName, resourceGroup, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Custom HTTPS to be enabled on Frontend Endpoint %q (Front Door %q / Resource Group %q): %+v", endpointName, frontDoorName, resourceGroup, err)
+		}
+	}
+
+	if !enableCustomHttps && (provisioningState == frontdoor.Enabled || provisioningState == frontdoor.Enabling) {
+		log.Printf("[DEBUG] Disabling Custom HTTPS on Frontend Endpoint %q (Front Door %q / Resource Group %q)", endpointName, frontDoorName, resourceGroup)
+		future, err := client.DisableHTTPS(ctx, resourceGroup, frontDoorName, endpointName)
+		if err != nil {
+			return fmt.Errorf("Error disabling Custom HTTPS on Frontend Endpoint %q (Front Door %q / Resource Group %q): %+v", endpointName, frontDoorName, resourceGroup, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Custom HTTPS to be disabled on Frontend Endpoint %q (Front Door %q / Resource Group %q): %+v", endpointName, frontDoorName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["frontDoors"]
+	// the API returns this segment with inconsistent casing
+	if name == "" {
+		name = id.Path["frontdoors"]
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Front Door %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("cname", props.Cname)
+		d.Set("load_balancer_enabled", props.EnabledState == frontdoor.EnabledStateEnumEnabled)
+
+		if err := d.Set("routing_rule", flattenArmFrontDoorRoutingRules(props.RoutingRules)); err != nil {
+			return fmt.Errorf("Error setting `routing_rule`: %+v", err)
+		}
+		if err := d.Set("backend_pool", flattenArmFrontDoorBackendPools(props.BackendPools)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool`: %+v", err)
+		}
+		if err := d.Set("frontend_endpoint", flattenArmFrontDoorFrontendEndpoints(props.FrontendEndpoints)); err != nil {
+			return fmt.Errorf("Error setting `frontend_endpoint`: %+v", err)
+		}
+		if err := d.Set("backend_pool_health_probe", flattenArmFrontDoorHealthProbeSettings(props.HealthProbeSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_health_probe`: %+v", err)
+		}
+		if err := d.Set("backend_pool_load_balancing", flattenArmFrontDoorLoadBalancingSettings(props.LoadBalancingSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_load_balancing`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFrontDoorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["frontDoors"]
+	if name == "" {
+		name = id.Path["frontdoors"]
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Front Door %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmFrontDoorRoutingRules(input []interface{}, subscriptionId, resourceGroup, frontDoorName string) *[]frontdoor.RoutingRule {
+	output := make([]frontdoor.RoutingRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		enabledState := frontdoor.EnabledStateEnumDisabled
+		if v["enabled"].(bool) {
+			enabledState = frontdoor.EnabledStateEnumEnabled
+		}
+
+		frontendEndpoints := make([]frontdoor.SubResource, 0)
+		for _, endpoint := range v["frontend_endpoints"].([]interface{}) {
+			frontendEndpoints = append(frontendEndpoints, frontdoor.SubResource{
+				ID: utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "frontendEndpoints", endpoint.(string))),
+			})
+		}
+
+		acceptedProtocols := make([]frontdoor.Protocol, 0)
+		for _, protocol := range v["accepted_protocols"].([]interface{}) {
+			acceptedProtocols = append(acceptedProtocols, frontdoor.Protocol(protocol.(string)))
+		}
+
+		patternsToMatch := make([]string, 0)
+		for _, pattern := range v["patterns_to_match"].([]interface{}) {
+			patternsToMatch = append(patternsToMatch, pattern.(string))
+		}
+
+		properties := frontdoor.RoutingRuleProperties{
+			FrontendEndpoints: &frontendEndpoints,
+			AcceptedProtocols: &acceptedProtocols,
+			PatternsToMatch:   &patternsToMatch,
+			EnabledState:      enabledState,
+		}
+
+		configs := v["forwarding_configuration"].([]interface{})
+		if len(configs) > 0 && configs[0] != nil {
+			config := configs[0].(map[string]interface{})
+
+			properties.BackendPool = &frontdoor.SubResource{
+				ID: utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "backendPools", config["backend_pool_name"].(string))),
+			}
+			properties.ForwardingProtocol = frontdoor.ForwardingProtocol(config["forwarding_protocol"].(string))
+
+			if path := config["custom_forwarding_path"].(string); path != "" {
+				properties.CustomForwardingPath = utils.String(path)
+			}
+
+			if config["cache_enabled"].(bool) {
+				dynamicCompression := frontdoor.DynamicCompressionEnabledDisabled
+				if config["cache_use_dynamic_compression"].(bool) {
+					dynamicCompression = frontdoor.DynamicCompressionEnabledEnabled
+				}
+
+				properties.CacheConfiguration = &frontdoor.CacheConfiguration{
+					QueryParameterStripDirective: frontdoor.Query(config["cache_query_parameter_strip_directive"].(string)),
+					DynamicCompression:           dynamicCompression,
+				}
+			}
+		}
+
+		output = append(output, frontdoor.RoutingRule{
+			ID:                    utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "routingRules", name)),
+			Name:                  utils.String(name),
+			RoutingRuleProperties: &properties,
+		})
+	}
+
+	return &output
+}
+
+func expandArmFrontDoorBackendPools(input []interface{}, subscriptionId, resourceGroup, frontDoorName string) *[]frontdoor.BackendPool {
+	output := make([]frontdoor.BackendPool, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		backends := make([]frontdoor.Backend, 0)
+		for _, b := range v["backend"].([]interface{}) {
+			backend := b.(map[string]interface{})
+
+			enabledState := frontdoor.EnabledStateEnumDisabled
+			if backend["enabled"].(bool) {
+				enabledState = frontdoor.EnabledStateEnumEnabled
+			}
+
+			backends = append(backends, frontdoor.Backend{
+				Address:           utils.String(backend["address"].(string)),
+				HTTPPort:          utils.Int32(int32(backend["http_port"].(int))),
+				HTTPSPort:         utils.Int32(int32(backend["https_port"].(int))),
+				BackendHostHeader: utils.String(backend["host_header"].(string)),
+				Priority:          utils.Int32(int32(backend["priority"].(int))),
+				Weight:            utils.Int32(int32(backend["weight"].(int))),
+				EnabledState:      enabledState,
+			})
+		}
+
+		output = append(output, frontdoor.BackendPool{
+			ID:   utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "backendPools", name)),
+			Name: utils.String(name),
+			BackendPoolProperties: &frontdoor.BackendPoolProperties{
+				Backends: &backends,
+				LoadBalancingSettings: &frontdoor.SubResource{
+					ID: utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "loadBalancingSettings", v["load_balancing_name"].(string))),
+				},
+				HealthProbeSettings: &frontdoor.SubResource{
+					ID: utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "healthProbeSettings", v["health_probe_name"].(string))),
+				},
+			},
+		})
+	}
+
+	return &output
+}
+
+func expandArmFrontDoorFrontendEndpoints(input []interface{}, subscriptionId, resourceGroup, frontDoorName string) *[]frontdoor.FrontendEndpoint {
+	output := make([]frontdoor.FrontendEndpoint, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		sessionAffinity := frontdoor.SessionAffinityEnabledStateDisabled
+		if v["session_affinity_enabled"].(bool) {
+			sessionAffinity = frontdoor.SessionAffinityEnabledStateEnabled
+		}
+
+		properties := frontdoor.FrontendEndpointProperties{
+			HostName:                    utils.String(v["host_name"].(string)),
+			SessionAffinityEnabledState: sessionAffinity,
+			SessionAffinityTTLSeconds:   utils.Int32(int32(v["session_affinity_ttl_seconds"].(int))),
+		}
+
+		if policyId := v["web_application_firewall_policy_link_id"].(string); policyId != "" {
+			properties.WebApplicationFirewallPolicyLink = &frontdoor.FrontendEndpointUpdateParametersWebApplicationFirewallPolicyLink{
+				ID: utils.String(policyId),
+			}
+		}
+
+		output = append(output, frontdoor.FrontendEndpoint{
+			ID:                         utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "frontendEndpoints", name)),
+			Name:                       utils.String(name),
+			FrontendEndpointProperties: &properties,
+		})
+	}
+
+	return &output
+}
+
+func expandArmFrontDoorHealthProbeSettings(input []interface{}, subscriptionId, resourceGroup, frontDoorName string) *[]frontdoor.HealthProbeSettingsModel {
+	output := make([]frontdoor.HealthProbeSettingsModel, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		output = append(output, frontdoor.HealthProbeSettingsModel{
+			ID:   utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "healthProbeSettings", name)),
+			Name: utils.String(name),
+			HealthProbeSettingsProperties: &frontdoor.HealthProbeSettingsProperties{
+				Path:              utils.String(v["path"].(string)),
+				Protocol:          frontdoor.Protocol(v["protocol"].(string)),
+				IntervalInSeconds: utils.Int32(int32(v["interval_in_seconds"].(int))),
+			},
+		})
+	}
+
+	return &output
+}
+
+func expandArmFrontDoorLoadBalancingSettings(input []interface{}, subscriptionId, resourceGroup, frontDoorName string) *[]frontdoor.LoadBalancingSettingsModel {
+	output := make([]frontdoor.LoadBalancingSettingsModel, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		output = append(output, frontdoor.LoadBalancingSettingsModel{
+			ID:   utils.String(frontDoorSubResourceID(subscriptionId, resourceGroup, frontDoorName, "loadBalancingSettings", name)),
+			Name: utils.String(name),
+			LoadBalancingSettingsProperties: &frontdoor.LoadBalancingSettingsProperties{
+				SampleSize:                    utils.Int32(int32(v["sample_size"].(int))),
+				SuccessfulSamplesRequired:     utils.Int32(int32(v["successful_samples_required"].(int))),
+				AdditionalLatencyMilliseconds: utils.Int32(int32(v["additional_latency_milliseconds"].(int))),
+			},
+		})
+	}
+
+	return &output
+}
+
+func expandArmFrontDoorCustomHTTPSConfiguration(input []interface{}) frontdoor.CustomHTTPSConfiguration {
+	output := frontdoor.CustomHTTPSConfiguration{
+		CertificateSource: frontdoor.CertificateSourceFrontDoor,
+		ProtocolType:      frontdoor.ServerNameIndication,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		output.CertificateSourceParameters = &frontdoor.CertificateSourceParameters{
+			CertificateType: frontdoor.Dedicated,
+		}
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	if v["certificate_source"].(string) == string(frontdoor.CertificateSourceAzureKeyVault) {
+		output.CertificateSource = frontdoor.CertificateSourceAzureKeyVault
+		output.KeyVaultCertificateSourceParameters = &frontdoor.KeyVaultCertificateSourceParameters{
+			Vault: &frontdoor.KeyVaultCertificateSourceParametersVault{
+				ID: utils.String(v["azure_key_vault_certificate_vault_id"].(string)),
+			},
+			SecretName:    utils.String(v["azure_key_vault_certificate_secret_name"].(string)),
+			SecretVersion: utils.String(v["azure_key_vault_certificate_secret_version"].(string)),
+		}
+	} else {
+		output.CertificateSourceParameters = &frontdoor.CertificateSourceParameters{
+			CertificateType: frontdoor.Dedicated,
+		}
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorRoutingRules(input *[]frontdoor.RoutingRule) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+
+		if props := v.RoutingRuleProperties; props != nil {
+			result["enabled"] = props.EnabledState == frontdoor.EnabledStateEnumEnabled
+
+			frontendEndpoints := make([]interface{}, 0)
+			if endpoints := props.FrontendEndpoints; endpoints != nil {
+				for _, endpoint := range *endpoints {
+					frontendEndpoints = append(frontendEndpoints, frontDoorSubResourceName(endpoint.ID))
+				}
+			}
+			result["frontend_endpoints"] = frontendEndpoints
+
+			acceptedProtocols := make([]interface{}, 0)
+			if protocols := props.AcceptedProtocols; protocols != nil {
+				for _, protocol := range *protocols {
+					acceptedProtocols = append(acceptedProtocols, string(protocol))
+				}
+			}
+			result["accepted_protocols"] = acceptedProtocols
+
+			patternsToMatch := make([]interface{}, 0)
+			if patterns := props.PatternsToMatch; patterns != nil {
+				for _, pattern := range *patterns {
+					patternsToMatch = append(patternsToMatch, pattern)
+				}
+			}
+			result["patterns_to_match"] = patternsToMatch
+
+			forwardingConfiguration := map[string]interface{}{
+				"forwarding_protocol":                   string(props.ForwardingProtocol),
+				"cache_enabled":                         false,
+				"cache_query_parameter_strip_directive": string(frontdoor.StripNone),
+				"cache_use_dynamic_compression":         false,
+			}
+			if pool := props.BackendPool; pool != nil {
+				forwardingConfiguration["backend_pool_name"] = frontDoorSubResourceName(pool.ID)
+			}
+			if path := props.CustomForwardingPath; path != nil {
+				forwardingConfiguration["custom_forwarding_path"] = *path
+			}
+			if cache := props.CacheConfiguration; cache != nil {
+				forwardingConfiguration["cache_enabled"] = true
+				forwardingConfiguration["cache_query_parameter_strip_directive"] = string(cache.QueryParameterStripDirective)
+				forwardingConfiguration["cache_use_dynamic_compression"] = cache.DynamicCompression == frontdoor.DynamicCompressionEnabledEnabled
+			}
+			result["forwarding_configuration"] = []interface{}{forwardingConfiguration}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorBackendPools(input *[]frontdoor.BackendPool) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+
+		if props := v.BackendPoolProperties; props != nil {
+			backends := make([]interface{}, 0)
+			if props.Backends != nil {
+				for _, backend := range *props.Backends {
+					b := map[string]interface{}{
+						"enabled": backend.EnabledState == frontdoor.EnabledStateEnumEnabled,
+					}
+					if backend.Address != nil {
+						b["address"] = *backend.Address
+					}
+					if backend.HTTPPort != nil {
+						b["http_port"] = int(*backend.HTTPPort)
+					}
+					if backend.HTTPSPort != nil {
+						b["https_port"] = int(*backend.HTTPSPort)
+					}
+					if backend.BackendHostHeader != nil {
+						b["host_header"] = *backend.BackendHostHeader
+					}
+					if backend.Priority != nil {
+						b["priority"] = int(*backend.Priority)
+					}
+					if backend.Weight != nil {
+						b["weight"] = int(*backend.Weight)
+					}
+					backends = append(backends, b)
+				}
+			}
+			result["backend"] = backends
+
+			if settings := props.LoadBalancingSettings; settings != nil {
+				result["load_balancing_name"] = frontDoorSubResourceName(settings.ID)
+			}
+			if settings := props.HealthProbeSettings; settings != nil {
+				result["health_probe_name"] = frontDoorSubResourceName(settings.ID)
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorFrontendEndpoints(input *[]frontdoor.FrontendEndpoint) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+
+		if props := v.FrontendEndpointProperties; props != nil {
+			if props.HostName != nil {
+				result["host_name"] = *props.HostName
+			}
+			result["session_affinity_enabled"] = props.SessionAffinityEnabledState == frontdoor.SessionAffinityEnabledStateEnabled
+			if props.SessionAffinityTTLSeconds != nil {
+				result["session_affinity_ttl_seconds"] = int(*props.SessionAffinityTTLSeconds)
+			}
+			if link := props.WebApplicationFirewallPolicyLink; link != nil && link.ID != nil {
+				result["web_application_firewall_policy_link_id"] = *link.ID
+			}
+
+			customHttpsEnabled := props.CustomHTTPSProvisioningState == frontdoor.Enabled || props.CustomHTTPSProvisioningState == frontdoor.Enabling
+			result["custom_https_provisioning_enabled"] = customHttpsEnabled
+
+			customHttpsConfigurations := make([]interface{}, 0)
+			if config := props.CustomHTTPSConfiguration; config != nil && customHttpsEnabled {
+				c := map[string]interface{}{
+					"certificate_source":    string(config.CertificateSource),
+					"provisioning_state":    string(props.CustomHTTPSProvisioningState),
+					"provisioning_substate": string(props.CustomHTTPSProvisioningSubstate),
+				}
+
+				if kv := config.KeyVaultCertificateSourceParameters; kv != nil {
+					if kv.Vault != nil && kv.Vault.ID != nil {
+						c["azure_key_vault_certificate_vault_id"] = *kv.Vault.ID
+					}
+					if kv.SecretName != nil {
+						c["azure_key_vault_certificate_secret_name"] = *kv.SecretName
+					}
+					if kv.SecretVersion != nil {
+						c["azure_key_vault_certificate_secret_version"] = *kv.SecretVersion
+					}
+				}
+
+				customHttpsConfigurations = append(customHttpsConfigurations, c)
+			}
+			result["custom_https_configuration"] = customHttpsConfigurations
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorHealthProbeSettings(input *[]frontdoor.HealthProbeSettingsModel) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+
+		if props := v.HealthProbeSettingsProperties; props != nil {
+			if props.Path != nil {
+				result["path"] = *props.Path
+			}
+			result["protocol"] = string(props.Protocol)
+			if props.IntervalInSeconds != nil {
+				result["interval_in_seconds"] = int(*props.IntervalInSeconds)
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorLoadBalancingSettings(input *[]frontdoor.LoadBalancingSettingsModel) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.ID != nil {
+			result["id"] = *v.ID
+		}
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+
+		if props := v.LoadBalancingSettingsProperties; props != nil {
+			if props.SampleSize != nil {
+				result["sample_size"] = int(*props.SampleSize)
+			}
+			if props.SuccessfulSamplesRequired != nil {
+				result["successful_samples_required"] = int(*props.SuccessfulSamplesRequired)
+			}
+			if props.AdditionalLatencyMilliseconds != nil {
+				result["additional_latency_milliseconds"] = int(*props.AdditionalLatencyMilliseconds)
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
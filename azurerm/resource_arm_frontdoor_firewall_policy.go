@@ -0,0 +1,571 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2018-08-01-preview/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFrontDoorFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFrontDoorFirewallPolicyCreateUpdate,
+		Read:   resourceArmFrontDoorFirewallPolicyRead,
+		Update: resourceArmFrontDoorFirewallPolicyCreateUpdate,
+		Delete: resourceArmFrontDoorFirewallPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorFirewallPolicyName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(frontdoor.Prevention),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(frontdoor.Detection),
+					string(frontdoor.Prevention),
+				}, false),
+			},
+
+			"custom_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.MatchRule),
+								string(frontdoor.RateLimitRule),
+							}, false),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.Allow),
+								string(frontdoor.Block),
+								string(frontdoor.Log),
+							}, false),
+						},
+
+						"rate_limit_duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"rate_limit_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"transforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(frontdoor.HTMLEntityDecode),
+									string(frontdoor.Lowercase),
+									string(frontdoor.RemoveNulls),
+									string(frontdoor.Trim),
+									string(frontdoor.Uppercase),
+									string(frontdoor.URLDecode),
+									string(frontdoor.URLEncode),
+								}, false),
+							},
+						},
+
+						"match_condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.PostArgs),
+											string(frontdoor.QueryString),
+											string(frontdoor.RemoteAddr),
+											string(frontdoor.RequestBody),
+											string(frontdoor.RequestHeader),
+											string(frontdoor.RequestMethod),
+											string(frontdoor.RequestURI),
+										}, false),
+									},
+
+									"selector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.Any),
+											string(frontdoor.BeginsWith),
+											string(frontdoor.Contains),
+											string(frontdoor.EndsWith),
+											string(frontdoor.Equal),
+											string(frontdoor.GeoMatch),
+											string(frontdoor.GreaterThan),
+											string(frontdoor.GreaterThanOrEqual),
+											string(frontdoor.IPMatch),
+											string(frontdoor.LessThan),
+											string(frontdoor.LessThanOrEqual),
+										}, false),
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"managed_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(frontdoor.RuleSetTypeAzureManagedRuleSet),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.RuleSetTypeAzureManagedRuleSet),
+							}, false),
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_group_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.SQLInjection),
+											string(frontdoor.XSS),
+										}, false),
+									},
+
+									"action": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.Allow),
+											string(frontdoor.Block),
+											string(frontdoor.Log),
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Front Door Firewall Policy creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_frontdoor_firewall_policy", *existing.ID)
+		}
+	}
+
+	enabledState := frontdoor.EnabledStateDisabled
+	if d.Get("enabled").(bool) {
+		enabledState = frontdoor.EnabledStateEnabled
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := frontdoor.WebApplicationFirewallPolicy1{
+		// Front Door Firewall Policies are global resources
+		Location: utils.String("Global"),
+		WebApplicationFirewallPolicyPropertiesFormat: &frontdoor.WebApplicationFirewallPolicyPropertiesFormat{
+			PolicySettings: &frontdoor.PolicySettings{
+				EnabledState: enabledState,
+				Mode:         frontdoor.Mode(d.Get("mode").(string)),
+			},
+			CustomRules:  expandArmFrontDoorFirewallCustomRules(d.Get("custom_rule").([]interface{})),
+			ManagedRules: expandArmFrontDoorFirewallManagedRules(d.Get("managed_rule").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Front Door Firewall Policy %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmFrontDoorFirewallPolicyRead(d, meta)
+}
+
+func resourceArmFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["FrontDoorWebApplicationFirewallPolicies"]
+	// the API returns this segment with inconsistent casing
+	if name == "" {
+		name = id.Path["frontDoorWebApplicationFirewallPolicies"]
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Front Door Firewall Policy %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.WebApplicationFirewallPolicyPropertiesFormat; props != nil {
+		if settings := props.PolicySettings; settings != nil {
+			d.Set("enabled", settings.EnabledState == frontdoor.EnabledStateEnabled)
+			d.Set("mode", string(settings.Mode))
+		}
+
+		if err := d.Set("custom_rule", flattenArmFrontDoorFirewallCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rule`: %+v", err)
+		}
+
+		if err := d.Set("managed_rule", flattenArmFrontDoorFirewallManagedRules(props.ManagedRules)); err != nil {
+			return fmt.Errorf("Error setting `managed_rule`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmFrontDoorFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).frontDoorsPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["FrontDoorWebApplicationFirewallPolicies"]
+	// the API returns this segment with inconsistent casing
+	if name == "" {
+		name = id.Path["frontDoorWebApplicationFirewallPolicies"]
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmFrontDoorFirewallCustomRules(input []interface{}) *frontdoor.CustomRules {
+	rules := make([]frontdoor.CustomRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		matchConditions := make([]frontdoor.MatchCondition1, 0)
+		for _, mc := range v["match_condition"].([]interface{}) {
+			condition := mc.(map[string]interface{})
+
+			matchValues := make([]string, 0)
+			for _, value := range condition["match_values"].([]interface{}) {
+				matchValues = append(matchValues, value.(string))
+			}
+
+			matchCondition := frontdoor.MatchCondition1{
+				MatchVariable:   frontdoor.MatchCondition(condition["match_variable"].(string)),
+				Operator:        frontdoor.Operator(condition["operator"].(string)),
+				NegateCondition: utils.Bool(condition["negation_condition"].(bool)),
+				MatchValue:      &matchValues,
+			}
+			if selector := condition["selector"].(string); selector != "" {
+				matchCondition.Selector = utils.String(selector)
+			}
+
+			matchConditions = append(matchConditions, matchCondition)
+		}
+
+		transforms := make([]frontdoor.Transform, 0)
+		for _, transform := range v["transforms"].([]interface{}) {
+			transforms = append(transforms, frontdoor.Transform(transform.(string)))
+		}
+
+		rule := frontdoor.CustomRule{
+			Name:            utils.String(v["name"].(string)),
+			Priority:        utils.Int32(int32(v["priority"].(int))),
+			RuleType:        frontdoor.RuleType(v["rule_type"].(string)),
+			Action:          frontdoor.Action(v["action"].(string)),
+			MatchConditions: &matchConditions,
+			Transforms:      &transforms,
+		}
+
+		if rule.RuleType == frontdoor.RateLimitRule {
+			rule.RateLimitDurationInMinutes = utils.Int32(int32(v["rate_limit_duration_in_minutes"].(int)))
+			rule.RateLimitThreshold = utils.Int32(int32(v["rate_limit_threshold"].(int)))
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &frontdoor.CustomRules{
+		Rules: &rules,
+	}
+}
+
+func expandArmFrontDoorFirewallManagedRules(input []interface{}) *frontdoor.ManagedRuleSets {
+	ruleSets := make([]frontdoor.BasicManagedRuleSet, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		overrides := make([]frontdoor.AzureManagedOverrideRuleGroup, 0)
+		for _, o := range v["override"].([]interface{}) {
+			override := o.(map[string]interface{})
+			overrides = append(overrides, frontdoor.AzureManagedOverrideRuleGroup{
+				RuleGroupOverride: frontdoor.RuleGroupOverride(override["rule_group_name"].(string)),
+				Action:            frontdoor.Action(override["action"].(string)),
+			})
+		}
+
+		ruleSets = append(ruleSets, frontdoor.AzureManagedRuleSet{
+			RuleSetType:        frontdoor.RuleSetType(v["type"].(string)),
+			Priority:           utils.Int32(int32(v["priority"].(int))),
+			Version:            utils.Int32(int32(v["version"].(int))),
+			RuleGroupOverrides: &overrides,
+		})
+	}
+
+	return &frontdoor.ManagedRuleSets{
+		RuleSets: &ruleSets,
+	}
+}
+
+func flattenArmFrontDoorFirewallCustomRules(input *frontdoor.CustomRules) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return output
+	}
+
+	for _, v := range *input.Rules {
+		result := map[string]interface{}{
+			"rule_type": string(v.RuleType),
+			"action":    string(v.Action),
+		}
+
+		if v.Name != nil {
+			result["name"] = *v.Name
+		}
+		if v.Priority != nil {
+			result["priority"] = int(*v.Priority)
+		}
+		if v.RateLimitDurationInMinutes != nil {
+			result["rate_limit_duration_in_minutes"] = int(*v.RateLimitDurationInMinutes)
+		}
+		if v.RateLimitThreshold != nil {
+			result["rate_limit_threshold"] = int(*v.RateLimitThreshold)
+		}
+
+		transforms := make([]interface{}, 0)
+		if v.Transforms != nil {
+			for _, transform := range *v.Transforms {
+				transforms = append(transforms, string(transform))
+			}
+		}
+		result["transforms"] = transforms
+
+		matchConditions := make([]interface{}, 0)
+		if v.MatchConditions != nil {
+			for _, condition := range *v.MatchConditions {
+				c := map[string]interface{}{
+					"match_variable": string(condition.MatchVariable),
+					"operator":       string(condition.Operator),
+				}
+				if condition.Selector != nil {
+					c["selector"] = *condition.Selector
+				}
+				if condition.NegateCondition != nil {
+					c["negation_condition"] = *condition.NegateCondition
+				}
+
+				matchValues := make([]interface{}, 0)
+				if condition.MatchValue != nil {
+					for _, value := range *condition.MatchValue {
+						matchValues = append(matchValues, value)
+					}
+				}
+				c["match_values"] = matchValues
+
+				matchConditions = append(matchConditions, c)
+			}
+		}
+		result["match_condition"] = matchConditions
+
+		output = append(output, result)
+	}
+
+	return output
+}
+
+func flattenArmFrontDoorFirewallManagedRules(input *frontdoor.ManagedRuleSets) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.RuleSets == nil {
+		return output
+	}
+
+	for _, raw := range *input.RuleSets {
+		ruleSet, ok := raw.AsAzureManagedRuleSet()
+		if !ok {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"type": string(frontdoor.RuleSetTypeAzureManagedRuleSet),
+		}
+		if ruleSet.Priority != nil {
+			result["priority"] = int(*ruleSet.Priority)
+		}
+		if ruleSet.Version != nil {
+			result["version"] = int(*ruleSet.Version)
+		}
+
+		overrides := make([]interface{}, 0)
+		if ruleSet.RuleGroupOverrides != nil {
+			for _, override := range *ruleSet.RuleGroupOverrides {
+				overrides = append(overrides, map[string]interface{}{
+					"rule_group_name": string(override.RuleGroupOverride),
+					"action":          string(override.Action),
+				})
+			}
+		}
+		result["override"] = overrides
+
+		output = append(output, result)
+	}
+
+	return output
+}
@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFrontDoorFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "Prevention"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoorFirewallPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFrontDoorFirewallPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_frontdoor_firewall_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoorFirewallPolicy_update(t *testing.T) {
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mode", "Detection"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.1.rate_limit_threshold", "10"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.0.override.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).frontDoorsPolicyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Front Door Firewall Policy %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on frontDoorsPolicyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFrontDoorFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).frontDoorsPolicyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_frontdoor_firewall_policy" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Front Door Firewall Policy still exists:\n%#v", resp.WebApplicationFirewallPolicyPropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "acctestfdfwpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFrontDoorFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_frontdoor_firewall_policy" "import" {
+  name                = "${azurerm_frontdoor_firewall_policy.test.name}"
+  resource_group_name = "${azurerm_frontdoor_firewall_policy.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "acctestfdfwpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  mode                = "Detection"
+
+  custom_rule {
+    name      = "BlockAddresses"
+    priority  = 1
+    rule_type = "MatchRule"
+    action    = "Block"
+
+    match_condition {
+      match_variable = "RemoteAddr"
+      operator       = "IPMatch"
+      match_values   = ["192.168.1.0/24", "10.0.0.0/24"]
+    }
+  }
+
+  custom_rule {
+    name                           = "RateLimitLogin"
+    priority                       = 2
+    rule_type                      = "RateLimitRule"
+    action                         = "Block"
+    rate_limit_duration_in_minutes = 1
+    rate_limit_threshold           = 10
+    transforms                     = ["Lowercase"]
+
+    match_condition {
+      match_variable = "RequestUri"
+      operator       = "Contains"
+      match_values   = ["/login"]
+    }
+  }
+
+  managed_rule {
+    type     = "AzureManagedRuleSet"
+    priority = 3
+
+    override {
+      rule_group_name = "SqlInjection"
+      action          = "Log"
+    }
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,447 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFrontDoor_basic(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "frontend_endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cname"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFrontDoor_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_frontdoor"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_update(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFrontDoor_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "friendly_name", "acctest"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.0.backend.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool_health_probe.0.interval_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.forwarding_configuration.0.cache_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.forwarding_configuration.0.cache_query_parameter_strip_directive", "StripAll"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_firewallPolicy(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_firewallPolicy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "frontend_endpoint.0.web_application_firewall_policy_link_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFrontDoorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).frontDoorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Front Door %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on frontDoorsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFrontDoorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).frontDoorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_frontdoor" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Front Door still exists:\n%#v", resp.Properties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFrontDoor_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+locals {
+  backend_name        = "backend-bing"
+  endpoint_name       = "frontend-endpoint"
+  health_probe_name   = "health-probe"
+  load_balancing_name = "load-balancing-setting"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                = "acctestfd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["${local.endpoint_name}"]
+
+    forwarding_configuration {
+      forwarding_protocol = "MatchRequest"
+      backend_pool_name   = "${local.backend_name}"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "${local.load_balancing_name}"
+  }
+
+  backend_pool_health_probe {
+    name = "${local.health_probe_name}"
+  }
+
+  backend_pool {
+    name = "${local.backend_name}"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "${local.load_balancing_name}"
+    health_probe_name   = "${local.health_probe_name}"
+  }
+
+  frontend_endpoint {
+    name      = "${local.endpoint_name}"
+    host_name = "acctestfd-%d.azurefd.net"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMFrontDoor_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFrontDoor_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_frontdoor" "import" {
+  name                = "${azurerm_frontdoor.test.name}"
+  resource_group_name = "${azurerm_frontdoor.test.resource_group_name}"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["${local.endpoint_name}"]
+
+    forwarding_configuration {
+      forwarding_protocol = "MatchRequest"
+      backend_pool_name   = "${local.backend_name}"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "${local.load_balancing_name}"
+  }
+
+  backend_pool_health_probe {
+    name = "${local.health_probe_name}"
+  }
+
+  backend_pool {
+    name = "${local.backend_name}"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "${local.load_balancing_name}"
+    health_probe_name   = "${local.health_probe_name}"
+  }
+
+  frontend_endpoint {
+    name      = "${local.endpoint_name}"
+    host_name = "acctestfd-%d.azurefd.net"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMFrontDoor_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+locals {
+  backend_name        = "backend-bing"
+  endpoint_name       = "frontend-endpoint"
+  health_probe_name   = "health-probe"
+  load_balancing_name = "load-balancing-setting"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                = "acctestfd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  friendly_name       = "acctest"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["${local.endpoint_name}"]
+
+    forwarding_configuration {
+      forwarding_protocol                   = "HttpsOnly"
+      backend_pool_name                     = "${local.backend_name}"
+      cache_enabled                         = true
+      cache_query_parameter_strip_directive = "StripAll"
+      cache_use_dynamic_compression         = true
+    }
+  }
+
+  backend_pool_load_balancing {
+    name                            = "${local.load_balancing_name}"
+    sample_size                     = 6
+    successful_samples_required     = 3
+    additional_latency_milliseconds = 10
+  }
+
+  backend_pool_health_probe {
+    name                = "${local.health_probe_name}"
+    path                = "/health"
+    protocol            = "Https"
+    interval_in_seconds = 60
+  }
+
+  backend_pool {
+    name = "${local.backend_name}"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    backend {
+      host_header = "www.microsoft.com"
+      address     = "www.microsoft.com"
+      http_port   = 80
+      https_port  = 443
+      priority    = 2
+      weight      = 25
+    }
+
+    load_balancing_name = "${local.load_balancing_name}"
+    health_probe_name   = "${local.health_probe_name}"
+  }
+
+  frontend_endpoint {
+    name                         = "${local.endpoint_name}"
+    host_name                    = "acctestfd-%d.azurefd.net"
+    session_affinity_enabled     = true
+    session_affinity_ttl_seconds = 300
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMFrontDoor_firewallPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "acctestfdfwpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+locals {
+  backend_name        = "backend-bing"
+  endpoint_name       = "frontend-endpoint"
+  health_probe_name   = "health-probe"
+  load_balancing_name = "load-balancing-setting"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                = "acctestfd-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["${local.endpoint_name}"]
+
+    forwarding_configuration {
+      backend_pool_name = "${local.backend_name}"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "${local.load_balancing_name}"
+  }
+
+  backend_pool_health_probe {
+    name = "${local.health_probe_name}"
+  }
+
+  backend_pool {
+    name = "${local.backend_name}"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "${local.load_balancing_name}"
+    health_probe_name   = "${local.health_probe_name}"
+  }
+
+  frontend_endpoint {
+    name                                    = "${local.endpoint_name}"
+    host_name                               = "acctestfd-%d.azurefd.net"
+    web_application_firewall_policy_link_id = "${azurerm_frontdoor_firewall_policy.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,341 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLoadBalancerOutboundRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLoadBalancerOutboundRuleCreateUpdate,
+		Read:   resourceArmLoadBalancerOutboundRuleRead,
+		Update: resourceArmLoadBalancerOutboundRuleCreateUpdate,
+		Delete: resourceArmLoadBalancerOutboundRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: loadBalancerSubResourceStateImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        ignoreCaseStateFunc,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.LoadBalancerOutboundRuleProtocolAll),
+					string(network.LoadBalancerOutboundRuleProtocolTCP),
+					string(network.LoadBalancerOutboundRuleProtocolUDP),
+				}, true),
+			},
+
+			"enable_tcp_reset": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"allocated_outbound_ports": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1024,
+				ValidateFunc: validation.IntBetween(0, 64000),
+			},
+
+			"idle_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(4, 120),
+			},
+		},
+	}
+}
+
+func resourceArmLoadBalancerOutboundRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	name := d.Get("name").(string)
+	armMutexKV.Lock(loadBalancerID)
+	defer armMutexKV.Unlock(loadBalancerID)
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer By ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
+	}
+
+	newOutboundRule, err := expandAzureRmLoadBalancerOutboundRule(d, loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error Expanding Load Balancer Outbound Rule: %+v", err)
+	}
+
+	outboundRules := make([]network.OutboundRule, 0)
+	if loadBalancer.LoadBalancerPropertiesFormat.OutboundRules != nil {
+		outboundRules = *loadBalancer.LoadBalancerPropertiesFormat.OutboundRules
+	}
+
+	existingOutboundRule, existingOutboundRuleIndex, exists := findLoadBalancerOutboundRuleByName(loadBalancer, name)
+	if exists {
+		if name == *existingOutboundRule.Name {
+			if requireResourcesToBeImported && d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_lb_outbound_rule", *existingOutboundRule.ID)
+			}
+
+			// this outbound rule is being updated/reapplied remove old copy from the slice
+			outboundRules = append(outboundRules[:existingOutboundRuleIndex], outboundRules[existingOutboundRuleIndex+1:]...)
+		}
+	}
+
+	outboundRules = append(outboundRules, *newOutboundRule)
+
+	loadBalancer.LoadBalancerPropertiesFormat.OutboundRules = &outboundRules
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(loadBalancerID)
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the completion of Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Load Balancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+	}
+
+	var outboundRuleId string
+	if props := read.LoadBalancerPropertiesFormat; props != nil && props.OutboundRules != nil {
+		for _, outboundRule := range *props.OutboundRules {
+			if outboundRule.Name != nil && *outboundRule.Name == name && outboundRule.ID != nil {
+				outboundRuleId = *outboundRule.ID
+			}
+		}
+	}
+
+	if outboundRuleId == "" {
+		return fmt.Errorf("Cannot find created Load Balancer Outbound Rule ID %q", outboundRuleId)
+	}
+
+	d.SetId(outboundRuleId)
+
+	return resourceArmLoadBalancerOutboundRuleRead(d, meta)
+}
+
+func resourceArmLoadBalancerOutboundRuleRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["outboundRules"]
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(d.Get("loadbalancer_id").(string), meta)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer %q not found. Removing from state", name)
+		return nil
+	}
+
+	config, _, exists := findLoadBalancerOutboundRuleByName(loadBalancer, name)
+	if !exists {
+		d.SetId("")
+		log.Printf("[INFO] Load Balancer Outbound Rule %q not found. Removing from state", name)
+		return nil
+	}
+
+	d.Set("name", config.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := config.OutboundRulePropertiesFormat; props != nil {
+		frontendIpConfigurations := make([]interface{}, 0)
+		if configs := props.FrontendIPConfigurations; configs != nil {
+			for _, feConfig := range *configs {
+				if feConfig.ID == nil {
+					continue
+				}
+				feConfigId, err := parseAzureResourceID(*feConfig.ID)
+				if err != nil {
+					return err
+				}
+
+				frontendIpConfigurations = append(frontendIpConfigurations, map[string]interface{}{
+					"id":   *feConfig.ID,
+					"name": feConfigId.Path["frontendIPConfigurations"],
+				})
+			}
+		}
+		if err := d.Set("frontend_ip_configuration", frontendIpConfigurations); err != nil {
+			return fmt.Errorf("Error setting `frontend_ip_configuration`: %+v", err)
+		}
+
+		if pool := props.BackendAddressPool; pool != nil {
+			d.Set("backend_address_pool_id", pool.ID)
+		}
+
+		d.Set("protocol", string(props.Protocol))
+		d.Set("enable_tcp_reset", props.EnableTCPReset)
+		d.Set("allocated_outbound_ports", props.AllocatedOutboundPorts)
+		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+	}
+
+	return nil
+}
+
+func resourceArmLoadBalancerOutboundRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).loadBalancerClient
+	ctx := meta.(*ArmClient).StopContext
+
+	loadBalancerID := d.Get("loadbalancer_id").(string)
+	armMutexKV.Lock(loadBalancerID)
+	defer armMutexKV.Unlock(loadBalancerID)
+
+	loadBalancer, exists, err := retrieveLoadBalancerById(loadBalancerID, meta)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer by ID: %+v", err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	_, index, exists := findLoadBalancerOutboundRuleByName(loadBalancer, d.Get("name").(string))
+	if !exists {
+		return nil
+	}
+
+	oldOutboundRules := *loadBalancer.LoadBalancerPropertiesFormat.OutboundRules
+	newOutboundRules := append(oldOutboundRules[:index], oldOutboundRules[index+1:]...)
+	loadBalancer.LoadBalancerPropertiesFormat.OutboundRules = &newOutboundRules
+
+	resGroup, loadBalancerName, err := resourceGroupAndLBNameFromId(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error Getting Load Balancer Name and Group:: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, loadBalancerName, *loadBalancer)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of the Load Balancer %q (Resource Group %q): %+v", loadBalancerName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, loadBalancerName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Load Balancer: %+v", err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Load Balancer %q (Resource Group %q) ID", loadBalancerName, resGroup)
+	}
+
+	return nil
+}
+
+func expandAzureRmLoadBalancerOutboundRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.OutboundRule, error) {
+	properties := network.OutboundRulePropertiesFormat{
+		Protocol:               network.LoadBalancerOutboundRuleProtocol(d.Get("protocol").(string)),
+		AllocatedOutboundPorts: utils.Int32(int32(d.Get("allocated_outbound_ports").(int))),
+		IdleTimeoutInMinutes:   utils.Int32(int32(d.Get("idle_timeout_in_minutes").(int))),
+		BackendAddressPool: &network.SubResource{
+			ID: utils.String(d.Get("backend_address_pool_id").(string)),
+		},
+	}
+
+	// TCP Reset is only supported when the protocol is TCP
+	enableTcpReset := d.Get("enable_tcp_reset").(bool)
+	if enableTcpReset {
+		if !strings.EqualFold(string(properties.Protocol), string(network.LoadBalancerOutboundRuleProtocolTCP)) {
+			return nil, fmt.Errorf("`enable_tcp_reset` can only be set when `protocol` is `Tcp`")
+		}
+		properties.EnableTCPReset = utils.Bool(enableTcpReset)
+	}
+
+	frontendIpConfigs := make([]network.SubResource, 0)
+	for _, raw := range d.Get("frontend_ip_configuration").([]interface{}) {
+		v := raw.(map[string]interface{})
+		feName := v["name"].(string)
+
+		rule, exists := findLoadBalancerFrontEndIpConfigurationByName(lb, feName)
+		if !exists {
+			return nil, fmt.Errorf("[ERROR] Cannot find FrontEnd IP Configuration with the name %s", feName)
+		}
+
+		frontendIpConfigs = append(frontendIpConfigs, network.SubResource{
+			ID: rule.ID,
+		})
+	}
+	properties.FrontendIPConfigurations = &frontendIpConfigs
+
+	return &network.OutboundRule{
+		Name:                         utils.String(d.Get("name").(string)),
+		OutboundRulePropertiesFormat: &properties,
+	}, nil
+}
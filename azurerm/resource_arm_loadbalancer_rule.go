@@ -5,7 +5,7 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -98,6 +98,16 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 				Default:  false,
 			},
 
+			"enable_tcp_reset": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"disable_outbound_snat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -227,6 +237,14 @@ func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) e
 			d.Set("enable_floating_ip", properties.EnableFloatingIP)
 		}
 
+		if properties.EnableTCPReset != nil {
+			d.Set("enable_tcp_reset", properties.EnableTCPReset)
+		}
+
+		if properties.DisableOutboundSnat != nil {
+			d.Set("disable_outbound_snat", properties.DisableOutboundSnat)
+		}
+
 		if properties.IdleTimeoutInMinutes != nil {
 			d.Set("idle_timeout_in_minutes", properties.IdleTimeoutInMinutes)
 		}
@@ -317,6 +335,14 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 		EnableFloatingIP: utils.Bool(d.Get("enable_floating_ip").(bool)),
 	}
 
+	if v := d.Get("enable_tcp_reset").(bool); v {
+		properties.EnableTCPReset = utils.Bool(v)
+	}
+
+	if v := d.Get("disable_outbound_snat").(bool); v {
+		properties.DisableOutboundSnat = utils.Bool(v)
+	}
+
 	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
 		properties.IdleTimeoutInMinutes = utils.Int32(int32(v.(int)))
 	}
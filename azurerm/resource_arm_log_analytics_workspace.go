@@ -44,7 +44,7 @@ func resourceArmLogAnalyticsWorkspace() *schema.Resource {
 					string(operationalinsights.Premium),
 					string(operationalinsights.Standalone),
 					string(operationalinsights.Standard),
-					string(operationalinsights.Unlimited),
+					"Unlimited",
 					string(operationalinsights.PerGB2018),
 				}, true),
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
@@ -357,7 +357,7 @@ func expandMonitorMetricAlertCriteria(input []interface{}) *insights.MetricAlert
 			MetricNamespace: utils.String(v["metric_namespace"].(string)),
 			MetricName:      utils.String(v["metric_name"].(string)),
 			TimeAggregation: v["aggregation"].(string),
-			Operator:        v["operator"].(string),
+			Operator:        insights.Operator(v["operator"].(string)),
 			Threshold:       utils.Float(v["threshold"].(float64)),
 			Dimensions:      &dimensions,
 		})
@@ -382,7 +382,7 @@ func expandMonitorMetricAlertAction(input []interface{}) *[]insights.MetricAlert
 
 			actions = append(actions, insights.MetricAlertAction{
 				ActionGroupID:     utils.String(agID),
-				WebhookProperties: props,
+				WebHookProperties: props,
 			})
 		}
 	}
@@ -410,9 +410,7 @@ func flattenMonitorMetricAlertCriteria(input insights.BasicMetricAlertCriteria)
 		if aggr, ok := metric.TimeAggregation.(string); ok {
 			v["aggregation"] = aggr
 		}
-		if op, ok := metric.Operator.(string); ok {
-			v["operator"] = op
-		}
+		v["operator"] = string(metric.Operator)
 		if metric.Threshold != nil {
 			v["threshold"] = *metric.Threshold
 		}
@@ -451,7 +449,7 @@ func flattenMonitorMetricAlertAction(input *[]insights.MetricAlertAction) (resul
 		}
 
 		props := make(map[string]string)
-		for pk, pv := range action.WebhookProperties {
+		for pk, pv := range action.WebHookProperties {
 			if pv != nil {
 				props[pk] = *pv
 			}
@@ -3,9 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -28,6 +29,8 @@ func resourceArmNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmNetworkInterfaceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -284,13 +287,16 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 			ID: &nsgId,
 		}
 
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(nsgId)
-		if err != nil {
-			return err
-		}
+		// the Network Security Group only needs locking when the association is being changed
+		if d.IsNewResource() || d.HasChange("network_security_group_id") {
+			networkSecurityGroupName, err := parseNetworkSecurityGroupName(nsgId)
+			if err != nil {
+				return err
+			}
 
-		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
-		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+			azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+			defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
+		}
 	}
 
 	dns, hasDns := d.GetOk("dns_servers")
@@ -321,11 +327,26 @@ func resourceArmNetworkInterfaceCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error Building list of Network Interface IP Configurations: %+v", sgErr)
 	}
 
-	azureRMLockMultipleByName(subnetnToLock, subnetResourceName)
-	defer azureRMUnlockMultipleByName(subnetnToLock, subnetResourceName)
+	// likewise the Subnets & Virtual Networks only need locking when the IP Configurations are being changed
+	if d.IsNewResource() || d.HasChange("ip_configuration") {
+		azureRMLockMultipleByName(subnetnToLock, subnetResourceName)
+		defer azureRMUnlockMultipleByName(subnetnToLock, subnetResourceName)
 
-	azureRMLockMultipleByName(vnnToLock, virtualNetworkResourceName)
-	defer azureRMUnlockMultipleByName(vnnToLock, virtualNetworkResourceName)
+		azureRMLockMultipleByName(vnnToLock, virtualNetworkResourceName)
+		defer azureRMUnlockMultipleByName(vnnToLock, virtualNetworkResourceName)
+	}
+
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.InterfacePropertiesFormat; props != nil {
+			previous, _ := d.GetChange("ip_configuration")
+			mergeNetworkInterfaceIPConfigurationAssociations(ipConfigs, previous.([]interface{}), props.IPConfigurations)
+		}
+	}
 
 	if len(ipConfigs) > 0 {
 		properties.IPConfigurations = &ipConfigs
@@ -408,6 +429,7 @@ func resourceArmNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 
 		if props.IPConfigurations != nil {
 			configs := flattenNetworkInterfaceIPConfigurations(props.IPConfigurations)
+			configs = orderNetworkInterfaceIPConfigurations(d.Get("ip_configuration").([]interface{}), configs)
 			if err := d.Set("ip_configuration", configs); err != nil {
 				return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
 			}
@@ -500,6 +522,10 @@ func resourceArmNetworkInterfaceDelete(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	// locks are always acquired in the same order to avoid deadlocking with other Network Interfaces
+	sort.Strings(subnetNamesToLock)
+	sort.Strings(virtualNetworkNamesToLock)
+
 	azureRMLockMultipleByName(&subnetNamesToLock, subnetResourceName)
 	defer azureRMUnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
 
@@ -715,22 +741,148 @@ func expandAzureRmNetworkInterfaceIpConfigurations(d *schema.ResourceData) ([]ne
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
 
-	// if we've got multiple IP Configurations - one must be designated Primary
-	if len(ipConfigs) > 1 {
-		hasPrimary := false
-		for _, config := range ipConfigs {
-			if config.Primary != nil && *config.Primary {
-				hasPrimary = true
+	// locks are always acquired in the same order to avoid deadlocking with other Network Interfaces
+	sort.Strings(subnetNamesToLock)
+	sort.Strings(virtualNetworkNamesToLock)
+
+	return ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+}
+
+// the Application Gateway, Load Balancer & Application Security Group fields within each IP Configuration are
+// deprecated in favour of the association resources - as such where one of these fields has never been managed
+// (that is, it's empty in the prior state) the associations which exist on the Network Interface are retained rather
+// than being removed when the Network Interface is updated. Once a field has been set, emptying it detaches them.
+func mergeNetworkInterfaceIPConfigurationAssociations(ipConfigs []network.InterfaceIPConfiguration, previous []interface{}, existing *[]network.InterfaceIPConfiguration) {
+	if existing == nil {
+		return
+	}
+
+	for i, config := range ipConfigs {
+		props := config.InterfaceIPConfigurationPropertiesFormat
+		if config.Name == nil || props == nil {
+			continue
+		}
+
+		managed := networkInterfaceIPConfigurationManagedFields(previous, *config.Name)
+
+		for _, existingConfig := range *existing {
+			existingProps := existingConfig.InterfaceIPConfigurationPropertiesFormat
+			if existingConfig.Name == nil || existingProps == nil || !strings.EqualFold(*existingConfig.Name, *config.Name) {
+				continue
+			}
+
+			if !managed["application_gateway_backend_address_pools_ids"] && (props.ApplicationGatewayBackendAddressPools == nil || len(*props.ApplicationGatewayBackendAddressPools) == 0) {
+				props.ApplicationGatewayBackendAddressPools = existingProps.ApplicationGatewayBackendAddressPools
+			}
+			if !managed["load_balancer_backend_address_pools_ids"] && (props.LoadBalancerBackendAddressPools == nil || len(*props.LoadBalancerBackendAddressPools) == 0) {
+				props.LoadBalancerBackendAddressPools = existingProps.LoadBalancerBackendAddressPools
+			}
+			if !managed["load_balancer_inbound_nat_rules_ids"] && (props.LoadBalancerInboundNatRules == nil || len(*props.LoadBalancerInboundNatRules) == 0) {
+				props.LoadBalancerInboundNatRules = existingProps.LoadBalancerInboundNatRules
+			}
+			if !managed["application_security_group_ids"] && (props.ApplicationSecurityGroups == nil || len(*props.ApplicationSecurityGroups) == 0) {
+				props.ApplicationSecurityGroups = existingProps.ApplicationSecurityGroups
+			}
+		}
+
+		ipConfigs[i].InterfaceIPConfigurationPropertiesFormat = props
+	}
+}
+
+// networkInterfaceIPConfigurationManagedFields returns the association fields which were set in the prior state
+// of the IP Configuration with the specified name
+func networkInterfaceIPConfigurationManagedFields(previous []interface{}, name string) map[string]bool {
+	managed := make(map[string]bool)
+	for _, raw := range previous {
+		config, ok := raw.(map[string]interface{})
+		if !ok || !strings.EqualFold(config["name"].(string), name) {
+			continue
+		}
+
+		for key, v := range config {
+			if set, ok := v.(*schema.Set); ok && set.Len() > 0 {
+				managed[key] = true
+			}
+		}
+	}
+
+	return managed
+}
+
+// the API returns the Primary IP Configuration first - so that reordering doesn't cause a diff, the IP Configurations
+// are returned in the order they're defined (matched by name), with any which aren't defined appended afterwards
+func orderNetworkInterfaceIPConfigurations(defined []interface{}, configs []interface{}) []interface{} {
+	output := make([]interface{}, 0, len(configs))
+	used := make([]bool, len(configs))
+
+	for _, raw := range defined {
+		if raw == nil {
+			continue
+		}
+		name := raw.(map[string]interface{})["name"].(string)
+
+		for i, config := range configs {
+			if !used[i] && strings.EqualFold(config.(map[string]interface{})["name"].(string), name) {
+				output = append(output, config)
+				used[i] = true
 				break
 			}
 		}
+	}
 
-		if !hasPrimary {
-			return nil, nil, nil, fmt.Errorf("If multiple `ip_configurations` are specified - one must be designated as `primary`.")
+	for i, config := range configs {
+		if !used[i] {
+			output = append(output, config)
 		}
 	}
 
-	return ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+	return output
+}
+
+func resourceArmNetworkInterfaceCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	return validateNetworkInterfaceIPConfigurations(d.Get("ip_configuration").([]interface{}))
+}
+
+// validateNetworkInterfaceIPConfigurations checks the names of the IP Configurations are unique and that exactly one
+// is Primary, which the API otherwise only rejects once the Network Interface is submitted
+func validateNetworkInterfaceIPConfigurations(configs []interface{}) error {
+	names := make(map[string]bool)
+	primaries := make([]string, 0)
+
+	for _, raw := range configs {
+		if raw == nil {
+			continue
+		}
+		config := raw.(map[string]interface{})
+		name := config["name"].(string)
+
+		if name != "" {
+			if names[strings.ToLower(name)] {
+				return fmt.Errorf("The name %q is used by more than one `ip_configuration`", name)
+			}
+			names[strings.ToLower(name)] = true
+		}
+
+		if primary, ok := config["primary"].(bool); ok && primary {
+			if strings.EqualFold(config["private_ip_address_version"].(string), string(network.IPv6)) {
+				return fmt.Errorf("The `ip_configuration` %q cannot be `primary` since it uses `IPv6` - the Primary IP Configuration must use `IPv4`", name)
+			}
+
+			primaries = append(primaries, name)
+		}
+	}
+
+	// a single IP Configuration is always made the Primary by the API
+	if len(configs) > 1 {
+		if len(primaries) == 0 {
+			return fmt.Errorf("If multiple `ip_configurations` are specified - one must be designated as `primary`.")
+		}
+		if len(primaries) > 1 {
+			return fmt.Errorf("Only one `ip_configuration` can be designated as `primary` but %q are - `primary` should be set to `false` on the others", strings.Join(primaries, ", "))
+		}
+	}
+
+	return nil
 }
 
 func sliceContainsValue(input []string, value string) bool {
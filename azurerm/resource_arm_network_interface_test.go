@@ -2,9 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -197,6 +200,29 @@ func TestAccAzureRMNetworkInterface_multipleSubnetsPrimary(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkInterface_multipleSubnetsPrimaryNotFirst(t *testing.T) {
+	resourceName := "azurerm_network_interface.test"
+	rInt := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterface_multipleSubnetsPrimaryNotFirst(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.0.primary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.0.name", "testconfiguration1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.1.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.1.name", "testconfiguration2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMNetworkInterface_enableIPForwarding(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
 	rInt := tf.AccRandTimeInt()
@@ -409,6 +435,170 @@ func TestAccAzureRMNetworkInterface_importPublicIP(t *testing.T) {
 	})
 }
 
+func TestValidateNetworkInterfaceIPConfigurations(t *testing.T) {
+	config := func(name string, primary bool, version string) interface{} {
+		return map[string]interface{}{
+			"name":                       name,
+			"primary":                    primary,
+			"private_ip_address_version": version,
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Configs       []interface{}
+		ErrorContains string
+	}{
+		{
+			Name:    "Single Implicit Primary",
+			Configs: []interface{}{config("first", false, "IPv4")},
+		},
+		{
+			Name:    "Primary Not First",
+			Configs: []interface{}{config("first", false, "IPv4"), config("second", true, "IPv4"), config("third", false, "IPv6")},
+		},
+		{
+			Name:          "Duplicate Names",
+			Configs:       []interface{}{config("first", true, "IPv4"), config("FIRST", false, "IPv4")},
+			ErrorContains: "used by more than one `ip_configuration`",
+		},
+		{
+			Name:          "No Primary",
+			Configs:       []interface{}{config("first", false, "IPv4"), config("second", false, "IPv4")},
+			ErrorContains: "one must be designated as `primary`",
+		},
+		{
+			Name:          "Multiple Primaries",
+			Configs:       []interface{}{config("first", true, "IPv4"), config("second", true, "IPv4")},
+			ErrorContains: "Only one `ip_configuration` can be designated as `primary`",
+		},
+		{
+			Name:          "IPv6 Primary",
+			Configs:       []interface{}{config("first", false, "IPv4"), config("second", true, "IPv6")},
+			ErrorContains: "must use `IPv4`",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateNetworkInterfaceIPConfigurations(v.Configs)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", v.Name, v.ErrorContains, err)
+		}
+	}
+}
+
+func TestOrderNetworkInterfaceIPConfigurations(t *testing.T) {
+	config := func(name string) interface{} {
+		return map[string]interface{}{
+			"name": name,
+		}
+	}
+
+	// the API returns the Primary IP Configuration first
+	configs := []interface{}{config("primary"), config("first"), config("unknown")}
+	defined := []interface{}{config("first"), config("Primary")}
+
+	output := orderNetworkInterfaceIPConfigurations(defined, configs)
+	expected := []string{"first", "primary", "unknown"}
+	if len(output) != len(expected) {
+		t.Fatalf("Expected %d IP Configurations but got %d", len(expected), len(output))
+	}
+	for i, name := range expected {
+		if actual := output[i].(map[string]interface{})["name"].(string); actual != name {
+			t.Fatalf("Expected IP Configuration %d to be %q but got %q", i, name, actual)
+		}
+	}
+
+	if output := orderNetworkInterfaceIPConfigurations([]interface{}{}, configs); output[0].(map[string]interface{})["name"].(string) != "primary" {
+		t.Fatalf("Expected the API ordering to be used when no IP Configurations are defined")
+	}
+}
+
+func TestMergeNetworkInterfaceIPConfigurationAssociations(t *testing.T) {
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+	otherPoolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool2"
+
+	existing := &[]network.InterfaceIPConfiguration{
+		{
+			Name: utils.String("first"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				LoadBalancerBackendAddressPools: &[]network.BackendAddressPool{{ID: utils.String(poolId)}},
+			},
+		},
+		{
+			Name: utils.String("second"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				LoadBalancerBackendAddressPools: &[]network.BackendAddressPool{{ID: utils.String(poolId)}},
+			},
+		},
+	}
+	ipConfigs := []network.InterfaceIPConfiguration{
+		{
+			Name: utils.String("First"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				LoadBalancerBackendAddressPools: &[]network.BackendAddressPool{},
+			},
+		},
+		{
+			Name: utils.String("second"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				LoadBalancerBackendAddressPools: &[]network.BackendAddressPool{{ID: utils.String(otherPoolId)}},
+			},
+		},
+		{
+			Name:                                     utils.String("third"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{},
+		},
+	}
+
+	mergeNetworkInterfaceIPConfigurationAssociations(ipConfigs, []interface{}{}, existing)
+
+	if pools := ipConfigs[0].LoadBalancerBackendAddressPools; pools == nil || len(*pools) != 1 || *(*pools)[0].ID != poolId {
+		t.Fatalf("Expected the existing Backend Address Pool to be retained for an IP Configuration which doesn't set any")
+	}
+	if pools := ipConfigs[1].LoadBalancerBackendAddressPools; pools == nil || len(*pools) != 1 || *(*pools)[0].ID != otherPoolId {
+		t.Fatalf("Expected the defined Backend Address Pool to be used for an IP Configuration which sets one")
+	}
+	if ipConfigs[2].LoadBalancerBackendAddressPools != nil {
+		t.Fatalf("Expected no Backend Address Pools for an IP Configuration which doesn't exist")
+	}
+
+	// once the field's been managed, removing its values detaches the Backend Address Pool
+	previous := []interface{}{
+		map[string]interface{}{
+			"name": "first",
+			"load_balancer_backend_address_pools_ids": schema.NewSet(schema.HashString, []interface{}{poolId}),
+			"application_security_group_ids":          schema.NewSet(schema.HashString, []interface{}{}),
+		},
+	}
+	ipConfigs = []network.InterfaceIPConfiguration{
+		{
+			Name: utils.String("first"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				LoadBalancerBackendAddressPools: &[]network.BackendAddressPool{},
+			},
+		},
+	}
+
+	mergeNetworkInterfaceIPConfigurationAssociations(ipConfigs, previous, existing)
+
+	if pools := ipConfigs[0].LoadBalancerBackendAddressPools; pools == nil || len(*pools) != 0 {
+		t.Fatalf("Expected the Backend Address Pool to be detached when it's removed from a managed field")
+	}
+}
+
 func testCheckAzureRMNetworkInterfaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -1398,3 +1588,46 @@ resource "azurerm_network_interface" "test" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMNetworkInterface_multipleSubnetsPrimaryNotFirst(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "testsubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    primary                       = false
+  }
+
+  ip_configuration {
+    name                          = "testconfiguration2"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    primary                       = true
+  }
+}
+`, rInt, location, rInt, rInt)
+}
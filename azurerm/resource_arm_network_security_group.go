@@ -1,10 +1,13 @@
 package azurerm
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
@@ -24,6 +27,8 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmNetworkSecurityGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -39,6 +44,7 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
+				Set:      resourceArmNetworkSecurityGroupRuleHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -88,27 +94,35 @@ func resourceArmNetworkSecurityGroup() *schema.Resource {
 						},
 
 						"source_address_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
 						},
 
 						"source_address_prefixes": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
+							},
+							Set: schema.HashString,
 						},
 
 						"destination_address_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
 						},
 
 						"destination_address_prefixes": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
+							},
+							Set: schema.HashString,
 						},
 
 						"destination_application_security_group_ids": {
@@ -485,3 +499,56 @@ func validateSecurityRule(sgRule map[string]interface{}) error {
 
 	return err.ErrorOrNil()
 }
+
+// security rules are keyed on their name and priority, so that changing any other field of a rule
+// shows up as an in-place change to that rule rather than the rule being removed and re-added
+func resourceArmNetworkSecurityGroupRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["name"].(string))))
+		buf.WriteString(fmt.Sprintf("%d-", m["priority"].(int)))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func resourceArmNetworkSecurityGroupCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	return validateNetworkSecurityGroupRules(d.Get("security_rule").(*schema.Set).List())
+}
+
+// validateNetworkSecurityGroupRules checks the inline rules don't reuse a name, or a priority within the same
+// direction - which the API would otherwise only reject once the Network Security Group is submitted
+func validateNetworkSecurityGroupRules(rules []interface{}) error {
+	names := make(map[string]bool)
+	priorities := make(map[string]string)
+
+	for _, raw := range rules {
+		rule := raw.(map[string]interface{})
+		name := rule["name"].(string)
+		priority := rule["priority"].(int)
+		direction := rule["direction"].(string)
+
+		// values which aren't known until apply are returned empty and can't be checked
+		if name == "" {
+			continue
+		}
+
+		if names[strings.ToLower(name)] {
+			return fmt.Errorf("The name %q is used by more than one `security_rule`", name)
+		}
+		names[strings.ToLower(name)] = true
+
+		if priority == 0 || direction == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s-%d", strings.ToLower(direction), priority)
+		if existing, ok := priorities[key]; ok {
+			return fmt.Errorf("The `security_rule`s %q and %q both have the priority %d in the %s direction", existing, name, priority, direction)
+		}
+		priorities[key] = name
+	}
+
+	return nil
+}
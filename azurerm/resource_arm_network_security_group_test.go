@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -85,6 +86,37 @@ func TestAccAzureRMNetworkSecurityGroup_singleRule(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkSecurityGroup_updateRuleDescription(t *testing.T) {
+	resourceName := "azurerm_network_security_group.test"
+	rInt := tf.AccRandTimeInt()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityGroup_singleRule(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkSecurityGroup_singleRuleWithDescription(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMNetworkSecurityGroup_update(t *testing.T) {
 	resourceName := "azurerm_network_security_group.test"
 	rInt := tf.AccRandTimeInt()
@@ -244,6 +276,92 @@ func TestAccAzureRMNetworkSecurityGroup_applicationSecurityGroup(t *testing.T) {
 	})
 }
 
+func TestValidateNetworkSecurityGroupRules(t *testing.T) {
+	rule := func(name string, priority int, direction string) interface{} {
+		return map[string]interface{}{
+			"name":      name,
+			"priority":  priority,
+			"direction": direction,
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Rules         []interface{}
+		ErrorContains string
+	}{
+		{
+			Name:  "No Rules",
+			Rules: []interface{}{},
+		},
+		{
+			Name:  "Same Priority In Different Directions",
+			Rules: []interface{}{rule("rule1", 100, "Inbound"), rule("rule2", 100, "Outbound")},
+		},
+		{
+			Name:  "Unknown Values Are Skipped",
+			Rules: []interface{}{rule("", 100, "Inbound"), rule("rule2", 0, ""), rule("rule3", 0, "")},
+		},
+		{
+			Name:          "Duplicate Names",
+			Rules:         []interface{}{rule("rule1", 100, "Inbound"), rule("RULE1", 200, "Inbound")},
+			ErrorContains: "used by more than one `security_rule`",
+		},
+		{
+			Name:          "Duplicate Priority",
+			Rules:         []interface{}{rule("rule1", 100, "Inbound"), rule("rule2", 100, "inbound")},
+			ErrorContains: "both have the priority 100",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateNetworkSecurityGroupRules(v.Rules)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", v.Name, v.ErrorContains, err)
+		}
+	}
+}
+
+func TestResourceArmNetworkSecurityGroupRuleHash(t *testing.T) {
+	rule := map[string]interface{}{
+		"name":        "rule1",
+		"priority":    100,
+		"direction":   "Inbound",
+		"description": "first",
+	}
+	updated := map[string]interface{}{
+		"name":        "Rule1",
+		"priority":    100,
+		"direction":   "Inbound",
+		"description": "second",
+	}
+	reprioritised := map[string]interface{}{
+		"name":        "rule1",
+		"priority":    200,
+		"direction":   "Inbound",
+		"description": "first",
+	}
+
+	if resourceArmNetworkSecurityGroupRuleHash(rule) != resourceArmNetworkSecurityGroupRuleHash(updated) {
+		t.Fatalf("Expected rules differing only by description and the casing of the name to hash the same")
+	}
+	if resourceArmNetworkSecurityGroupRuleHash(rule) == resourceArmNetworkSecurityGroupRuleHash(reprioritised) {
+		t.Fatalf("Expected rules with different priorities to hash differently")
+	}
+}
+
 func testCheckAzureRMNetworkSecurityGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -382,6 +500,34 @@ resource "azurerm_network_security_group" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMNetworkSecurityGroup_singleRuleWithDescription(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  security_rule {
+    name                       = "test123"
+    description                = "Allow all inbound traffic"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "TCP"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, rInt, location)
+}
+
 func testAccAzureRMNetworkSecurityGroup_anotherRule(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
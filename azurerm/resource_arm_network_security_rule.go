@@ -2,8 +2,9 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -20,6 +21,8 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmNetworkSecurityRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -84,12 +87,16 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"source_address_prefixes"},
+				ValidateFunc:  validateNetworkAddressPrefixOrServiceTag,
 			},
 
 			"source_address_prefixes": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
+				},
 				Set:           schema.HashString,
 				ConflictsWith: []string{"source_address_prefix"},
 			},
@@ -98,12 +105,16 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"destination_address_prefixes"},
+				ValidateFunc:  validateNetworkAddressPrefixOrServiceTag,
 			},
 
 			"destination_address_prefixes": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkAddressPrefixOrServiceTag,
+				},
 				Set:           schema.HashString,
 				ConflictsWith: []string{"destination_address_prefix"},
 			},
@@ -368,6 +379,65 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceArmNetworkSecurityRuleCustomizeDiff checks the priority of this rule isn't already in use by another rule
+// in the same direction - such as an inline `security_rule` on the `azurerm_network_security_group`
+func resourceArmNetworkSecurityRuleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("priority") && !d.HasChange("direction") {
+		return nil
+	}
+
+	for _, key := range []string{"name", "resource_group_name", "network_security_group_name", "priority", "direction"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	client := meta.(*ArmClient).secGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	nsgName := d.Get("network_security_group_name").(string)
+
+	resp, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		// the Network Security Group may be created in the same apply
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %+v", nsgName, resGroup, err)
+	}
+
+	if props := resp.SecurityGroupPropertiesFormat; props != nil {
+		return validateNetworkSecurityRuleClash(props.SecurityRules, name, d.Get("priority").(int), d.Get("direction").(string))
+	}
+
+	return nil
+}
+
+func validateNetworkSecurityRuleClash(rules *[]network.SecurityRule, name string, priority int, direction string) error {
+	if rules == nil {
+		return nil
+	}
+
+	for _, rule := range *rules {
+		if rule.Name == nil || strings.EqualFold(*rule.Name, name) {
+			continue
+		}
+
+		props := rule.SecurityRulePropertiesFormat
+		if props == nil || props.Priority == nil {
+			continue
+		}
+
+		if int(*props.Priority) == priority && strings.EqualFold(string(props.Direction), direction) {
+			return fmt.Errorf("The priority %d in the %s direction is already used by the Security Rule %q", priority, direction, *rule.Name)
+		}
+	}
+
+	return nil
+}
+
 func flattenApplicationSecurityGroupIds(groups *[]network.ApplicationSecurityGroup) []string {
 	ids := make([]string, 0)
 
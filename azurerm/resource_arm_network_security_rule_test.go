@@ -3,8 +3,11 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -156,6 +159,84 @@ func TestAccAzureRMNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMNetworkSecurityRule_priorityClash(t *testing.T) {
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkSecurityGroup_singleRule(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkSecurityGroupExists("azurerm_network_security_group.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMNetworkSecurityRule_priorityClash(rInt, location),
+				ExpectError: regexp.MustCompile("already used by the Security Rule \"test123\""),
+			},
+		},
+	})
+}
+
+func TestValidateNetworkSecurityRuleClash(t *testing.T) {
+	rules := &[]network.SecurityRule{
+		{
+			Name: utils.String("inline"),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Priority:  utils.Int32(100),
+				Direction: network.SecurityRuleDirectionInbound,
+			},
+		},
+	}
+
+	cases := []struct {
+		Name      string
+		Priority  int
+		Direction string
+		Error     bool
+	}{
+		{
+			Name:      "other",
+			Priority:  200,
+			Direction: "Inbound",
+		},
+		{
+			Name:      "other",
+			Priority:  100,
+			Direction: "Outbound",
+		},
+		{
+			Name:      "INLINE",
+			Priority:  100,
+			Direction: "Inbound",
+		},
+		{
+			Name:      "other",
+			Priority:  100,
+			Direction: "inbound",
+			Error:     true,
+		},
+	}
+
+	for _, v := range cases {
+		err := validateNetworkSecurityRuleClash(rules, v.Name, v.Priority, v.Direction)
+		if v.Error && (err == nil || !strings.Contains(err.Error(), "\"inline\"")) {
+			t.Fatalf("Expected a clash for %q (%d %s) but got: %+v", v.Name, v.Priority, v.Direction, err)
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no clash for %q (%d %s) but got: %+v", v.Name, v.Priority, v.Direction, err)
+		}
+	}
+
+	if err := validateNetworkSecurityRuleClash(nil, "other", 100, "Inbound"); err != nil {
+		t.Fatalf("Expected no clash when there are no rules but got: %+v", err)
+	}
+}
+
 func testCheckAzureRMNetworkSecurityRuleExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -433,3 +514,24 @@ resource "azurerm_network_security_rule" "test1" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMNetworkSecurityRule_priorityClash(rInt int, location string) string {
+	template := testAccAzureRMNetworkSecurityGroup_singleRule(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_rule" "test" {
+  name                        = "test456"
+  network_security_group_name = "${azurerm_network_security_group.test.name}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  priority                    = 100
+  direction                   = "Inbound"
+  access                      = "Deny"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+`, template)
+}
@@ -0,0 +1,538 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"private_connection_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"request_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_dns_zone_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"private_dns_zone_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Network", "privateDnsZones"),
+							},
+						},
+					},
+				},
+			},
+
+			"custom_dns_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Private Endpoint creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := network.PrivateEndpointProperties{
+		Subnet: &network.Subnet{
+			ID: utils.String(subnetId),
+		},
+	}
+
+	connections, isManual := expandArmPrivateEndpointServiceConnections(d.Get("private_service_connection").([]interface{}))
+	if isManual {
+		properties.ManualPrivateLinkServiceConnections = connections
+	} else {
+		properties.PrivateLinkServiceConnections = connections
+	}
+
+	parameters := network.PrivateEndpoint{
+		Location:                  utils.String(location),
+		PrivateEndpointProperties: &properties,
+		Tags:                      expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if d.IsNewResource() || d.HasChange("private_dns_zone_group") {
+		if err := updateArmPrivateEndpointDNSZoneGroup(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Private Endpoint %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+// updateArmPrivateEndpointDNSZoneGroup replaces the Private DNS Zone Group for the Private Endpoint, which Azure
+// uses to manage the DNS Records for the Private Endpoint within each of the Private DNS Zones
+func updateArmPrivateEndpointDNSZoneGroup(d *schema.ResourceData, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*ArmClient).privateDNSZoneGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	oldRaw, newRaw := d.GetChange("private_dns_zone_group")
+
+	// a Private Endpoint can only have a single Private DNS Zone Group, so the existing one has to be removed first
+	if oldGroups := oldRaw.([]interface{}); len(oldGroups) > 0 && oldGroups[0] != nil {
+		oldName := oldGroups[0].(map[string]interface{})["name"].(string)
+
+		future, err := client.Delete(ctx, resourceGroup, name, oldName)
+		if err != nil {
+			if !response.WasNotFound(future.Response()) {
+				return fmt.Errorf("Error deleting Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", oldName, name, resourceGroup, err)
+			}
+		} else {
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", oldName, name, resourceGroup, err)
+			}
+		}
+	}
+
+	newGroups := newRaw.([]interface{})
+	if len(newGroups) == 0 || newGroups[0] == nil {
+		return nil
+	}
+
+	group := newGroups[0].(map[string]interface{})
+	groupName := group["name"].(string)
+	parameters := network.PrivateDNSZoneGroup{
+		PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: expandArmPrivateEndpointDNSZoneConfigs(group["private_dns_zone_ids"].([]interface{})),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, groupName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", groupName, name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Private DNS Zone Group %q (Private Endpoint %q / Resource Group %q): %+v", groupName, name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	dnsZoneGroupsClient := meta.(*ArmClient).privateDNSZoneGroupClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateEndpoints"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private Endpoint %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateEndpointProperties; props != nil {
+		subnetId := ""
+		if subnet := props.Subnet; subnet != nil && subnet.ID != nil {
+			subnetId = *subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		privateIPAddress, err := retrieveArmPrivateEndpointIPAddress(meta, props.NetworkInterfaces)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Private IP Address of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		connections := flattenArmPrivateEndpointServiceConnections(props.PrivateLinkServiceConnections, false, privateIPAddress)
+		connections = append(connections, flattenArmPrivateEndpointServiceConnections(props.ManualPrivateLinkServiceConnections, true, privateIPAddress)...)
+		if err := d.Set("private_service_connection", connections); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+
+		if err := d.Set("custom_dns_configs", flattenArmPrivateEndpointCustomDNSConfigs(props.CustomDNSConfigs)); err != nil {
+			return fmt.Errorf("Error setting `custom_dns_configs`: %+v", err)
+		}
+	}
+
+	groups := make([]network.PrivateDNSZoneGroup, 0)
+	groupsIterator, err := dnsZoneGroupsClient.ListComplete(ctx, name, resourceGroup)
+	if err != nil {
+		return fmt.Errorf("Error listing Private DNS Zone Groups for Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for groupsIterator.NotDone() {
+		groups = append(groups, groupsIterator.Value())
+
+		if err := groupsIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Private DNS Zone Groups for Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+	if err := d.Set("private_dns_zone_group", flattenArmPrivateEndpointDNSZoneGroups(groups)); err != nil {
+		return fmt.Errorf("Error setting `private_dns_zone_group`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateEndpoints"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// retrieveArmPrivateEndpointIPAddress returns the Private IP Address allocated to the Network Interface
+// which Azure creates for the Private Endpoint
+func retrieveArmPrivateEndpointIPAddress(meta interface{}, networkInterfaces *[]network.Interface) (*string, error) {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	if networkInterfaces == nil || len(*networkInterfaces) == 0 || (*networkInterfaces)[0].ID == nil {
+		return nil, nil
+	}
+
+	id, err := parseAzureResourceID(*(*networkInterfaces)[0].ID)
+	if err != nil {
+		return nil, err
+	}
+	name := id.Path["networkInterfaces"]
+
+	nic, err := client.Get(ctx, id.ResourceGroup, name, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, id.ResourceGroup, err)
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if configProps := config.InterfaceIPConfigurationPropertiesFormat; configProps != nil && configProps.PrivateIPAddress != nil {
+				return configProps.PrivateIPAddress, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func expandArmPrivateEndpointServiceConnections(input []interface{}) (*[]network.PrivateLinkServiceConnection, bool) {
+	results := make([]network.PrivateLinkServiceConnection, 0)
+	isManual := false
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		isManual = v["is_manual_connection"].(bool)
+
+		properties := network.PrivateLinkServiceConnectionProperties{
+			PrivateLinkServiceID: utils.String(v["private_connection_resource_id"].(string)),
+			GroupIds:             utils.ExpandStringArray(v["subresource_names"].([]interface{})),
+		}
+
+		// the request message is only used when the connection needs to be approved
+		if requestMessage := v["request_message"].(string); requestMessage != "" && isManual {
+			properties.RequestMessage = utils.String(requestMessage)
+		}
+
+		results = append(results, network.PrivateLinkServiceConnection{
+			Name:                                   utils.String(v["name"].(string)),
+			PrivateLinkServiceConnectionProperties: &properties,
+		})
+	}
+
+	return &results, isManual
+}
+
+func flattenArmPrivateEndpointServiceConnections(input *[]network.PrivateLinkServiceConnection, isManual bool, privateIPAddress *string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateConnectionResourceId := ""
+		requestMessage := ""
+		subresourceNames := make([]interface{}, 0)
+		if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if props.PrivateLinkServiceID != nil {
+				privateConnectionResourceId = *props.PrivateLinkServiceID
+			}
+			if props.RequestMessage != nil && isManual {
+				requestMessage = *props.RequestMessage
+			}
+			subresourceNames = utils.FlattenStringArray(props.GroupIds)
+		}
+
+		ipAddress := ""
+		if privateIPAddress != nil {
+			ipAddress = *privateIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                           name,
+			"is_manual_connection":           isManual,
+			"private_connection_resource_id": privateConnectionResourceId,
+			"subresource_names":              subresourceNames,
+			"request_message":                requestMessage,
+			"private_ip_address":             ipAddress,
+		})
+	}
+
+	return results
+}
+
+func expandArmPrivateEndpointDNSZoneConfigs(input []interface{}) *[]network.PrivateDNSZoneConfig {
+	results := make([]network.PrivateDNSZoneConfig, 0)
+
+	for _, item := range input {
+		zoneId := item.(string)
+
+		// the name of the config has to be unique within the group, so the name of the zone is used
+		id, err := parseAzureResourceID(zoneId)
+		name := zoneId
+		if err == nil {
+			name = id.Path["privateDnsZones"]
+		}
+
+		results = append(results, network.PrivateDNSZoneConfig{
+			Name: utils.String(name),
+			PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{
+				PrivateDNSZoneID: utils.String(zoneId),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateEndpointDNSZoneGroups(input []network.PrivateDNSZoneGroup) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		zoneIds := make([]interface{}, 0)
+		if props := item.PrivateDNSZoneGroupPropertiesFormat; props != nil && props.PrivateDNSZoneConfigs != nil {
+			for _, config := range *props.PrivateDNSZoneConfigs {
+				if config.PrivateDNSZonePropertiesFormat != nil && config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID != nil {
+					zoneIds = append(zoneIds, *config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID)
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"private_dns_zone_ids": zoneIds,
+		})
+	}
+
+	return results
+}
+
+func flattenArmPrivateEndpointCustomDNSConfigs(input *[]network.CustomDNSConfigPropertiesFormat) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		fqdn := ""
+		if item.Fqdn != nil {
+			fqdn = *item.Fqdn
+		}
+
+		results = append(results, map[string]interface{}{
+			"fqdn":         fqdn,
+			"ip_addresses": utils.FlattenStringArray(item.IPAddresses),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,368 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandArmPrivateEndpointDNSZoneConfigs(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Input         []interface{}
+		ExpectedNames []string
+	}{
+		{
+			Name:          "Empty",
+			Input:         []interface{}{},
+			ExpectedNames: []string{},
+		},
+		{
+			Name: "Single Zone",
+			Input: []interface{}{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net",
+			},
+			ExpectedNames: []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name: "Multiple Zones",
+			Input: []interface{}{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/privatelink.blob.core.windows.net",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/privateDnsZones/privatelink.queue.core.windows.net",
+			},
+			ExpectedNames: []string{"privatelink.blob.core.windows.net", "privatelink.queue.core.windows.net"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		configs := *expandArmPrivateEndpointDNSZoneConfigs(tc.Input)
+		if len(configs) != len(tc.ExpectedNames) {
+			t.Fatalf("Expected %d configs but got %d", len(tc.ExpectedNames), len(configs))
+		}
+
+		for i, config := range configs {
+			if *config.Name != tc.ExpectedNames[i] {
+				t.Fatalf("Expected config %d to be named %q but got %q", i, tc.ExpectedNames[i], *config.Name)
+			}
+
+			if *config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID != tc.Input[i].(string) {
+				t.Fatalf("Expected config %d to reference %q but got %q", i, tc.Input[i].(string), *config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID)
+			}
+		}
+
+		// the zone ids should round-trip through the flatten
+		groups := []network.PrivateDNSZoneGroup{
+			{
+				Name: utils.String("default"),
+				PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+					PrivateDNSZoneConfigs: &configs,
+				},
+			},
+		}
+		flattened := flattenArmPrivateEndpointDNSZoneGroups(groups)
+		if len(flattened) != 1 {
+			t.Fatalf("Expected 1 group but got %d", len(flattened))
+		}
+
+		group := flattened[0].(map[string]interface{})
+		if group["name"].(string) != "default" {
+			t.Fatalf("Expected the group to be named %q but got %q", "default", group["name"].(string))
+		}
+
+		zoneIds := group["private_dns_zone_ids"].([]interface{})
+		if len(zoneIds) != len(tc.Input) {
+			t.Fatalf("Expected %d zone ids but got %d", len(tc.Input), len(zoneIds))
+		}
+		for i, zoneId := range zoneIds {
+			if zoneId.(string) != tc.Input[i].(string) {
+				t.Fatalf("Expected zone id %d to be %q but got %q", i, tc.Input[i].(string), zoneId.(string))
+			}
+		}
+	}
+}
+
+func TestAccAzureRMPrivateEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.is_manual_connection", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_service_connection.0.private_ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateEndpoint_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_manualConnection(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_manualConnection(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.is_manual_connection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.subresource_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.subresource_names.0", "blob"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.request_message", "plz approve my request"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).privateEndpointClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private Endpoint %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on privateEndpointClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateEndpointClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_endpoint" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private Endpoint still exists:\n%#v", resp.PrivateEndpointProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateEndpoint_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.5.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnet-endpoint-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.5.2.0/24"
+
+  private_endpoint_network_policies_enabled = false
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "service" {
+  name                 = "acctestsnet-service-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.5.1.0/24"
+
+  private_link_service_network_policies_enabled = false
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                 = "${azurerm_public_ip.test.name}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_private_link_service" "test" {
+  name                = "acctestpls-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  load_balancer_frontend_ip_configuration_ids = ["${azurerm_lb.test.frontend_ip_configuration.0.id}"]
+
+  nat_ip_configuration {
+    name      = "primaryIpConfiguration-%d"
+    subnet_id = "${azurerm_subnet.service.id}"
+    primary   = true
+  }
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+  }
+}
+`, template, rInt, rInt, rInt, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "import" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  location            = "${azurerm_private_endpoint.test.location}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+  subnet_id           = "${azurerm_private_endpoint.test.subnet_id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_manualConnection(rInt int, rString string, location string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    is_manual_connection           = true
+    private_connection_resource_id = "${azurerm_storage_account.test.id}"
+    subresource_names              = ["blob"]
+    request_message                = "plz approve my request"
+  }
+}
+`, template, rString, rInt, rInt)
+}
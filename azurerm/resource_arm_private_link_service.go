@@ -0,0 +1,370 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateLinkService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateLinkServiceCreateUpdate,
+		Read:   resourceArmPrivateLinkServiceRead,
+		Update: resourceArmPrivateLinkServiceCreateUpdate,
+		Delete: resourceArmPrivateLinkServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"load_balancer_frontend_ip_configuration_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"nat_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"primary": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IPv4),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IPv4),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"auto_approval_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"visibility_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"enable_proxy_protocol": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateLinkServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Private Link Service creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_link_service", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.PrivateLinkService{
+		Location: utils.String(location),
+		PrivateLinkServiceProperties: &network.PrivateLinkServiceProperties{
+			LoadBalancerFrontendIPConfigurations: expandArmPrivateLinkServiceFrontendIPConfigurations(d.Get("load_balancer_frontend_ip_configuration_ids").(*schema.Set).List()),
+			IPConfigurations:                     expandArmPrivateLinkServiceIPConfigurations(d.Get("nat_ip_configuration").([]interface{})),
+			AutoApproval: &network.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: utils.ExpandStringArray(d.Get("auto_approval_subscription_ids").(*schema.Set).List()),
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: utils.ExpandStringArray(d.Get("visibility_subscription_ids").(*schema.Set).List()),
+			},
+			EnableProxyProtocol: utils.Bool(d.Get("enable_proxy_protocol").(bool)),
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Private Link Service %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPrivateLinkServiceRead(d, meta)
+}
+
+func resourceArmPrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateLinkServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateLinkServices"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private Link Service %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		d.Set("alias", props.Alias)
+		d.Set("enable_proxy_protocol", props.EnableProxyProtocol)
+
+		if err := d.Set("load_balancer_frontend_ip_configuration_ids", flattenArmPrivateLinkServiceFrontendIPConfigurations(props.LoadBalancerFrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `load_balancer_frontend_ip_configuration_ids`: %+v", err)
+		}
+
+		if err := d.Set("nat_ip_configuration", flattenArmPrivateLinkServiceIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `nat_ip_configuration`: %+v", err)
+		}
+
+		autoApprovalSubscriptionIds := make([]interface{}, 0)
+		if autoApproval := props.AutoApproval; autoApproval != nil {
+			autoApprovalSubscriptionIds = utils.FlattenStringArray(autoApproval.Subscriptions)
+		}
+		if err := d.Set("auto_approval_subscription_ids", autoApprovalSubscriptionIds); err != nil {
+			return fmt.Errorf("Error setting `auto_approval_subscription_ids`: %+v", err)
+		}
+
+		visibilitySubscriptionIds := make([]interface{}, 0)
+		if visibility := props.Visibility; visibility != nil {
+			visibilitySubscriptionIds = utils.FlattenStringArray(visibility.Subscriptions)
+		}
+		if err := d.Set("visibility_subscription_ids", visibilitySubscriptionIds); err != nil {
+			return fmt.Errorf("Error setting `visibility_subscription_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPrivateLinkServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateLinkServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["privateLinkServices"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmPrivateLinkServiceIPConfigurations(input []interface{}) *[]network.PrivateLinkServiceIPConfiguration {
+	results := make([]network.PrivateLinkServiceIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		properties := network.PrivateLinkServiceIPConfigurationProperties{
+			PrivateIPAllocationMethod: network.Dynamic,
+			PrivateIPAddressVersion:   network.IPVersion(v["private_ip_address_version"].(string)),
+			Subnet: &network.Subnet{
+				ID: utils.String(v["subnet_id"].(string)),
+			},
+			Primary: utils.Bool(v["primary"].(bool)),
+		}
+
+		if privateIPAddress := v["private_ip_address"].(string); privateIPAddress != "" {
+			properties.PrivateIPAddress = utils.String(privateIPAddress)
+			properties.PrivateIPAllocationMethod = network.Static
+		}
+
+		results = append(results, network.PrivateLinkServiceIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			PrivateLinkServiceIPConfigurationProperties: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceIPConfigurations(input *[]network.PrivateLinkServiceIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		subnetId := ""
+		privateIPAddress := ""
+		privateIPAddressVersion := ""
+		primary := false
+		if props := item.PrivateLinkServiceIPConfigurationProperties; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+			if props.PrivateIPAddress != nil {
+				privateIPAddress = *props.PrivateIPAddress
+			}
+			privateIPAddressVersion = string(props.PrivateIPAddressVersion)
+			if props.Primary != nil {
+				primary = *props.Primary
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                       name,
+			"subnet_id":                  subnetId,
+			"primary":                    primary,
+			"private_ip_address":         privateIPAddress,
+			"private_ip_address_version": privateIPAddressVersion,
+		})
+	}
+
+	return results
+}
+
+func expandArmPrivateLinkServiceFrontendIPConfigurations(input []interface{}) *[]network.FrontendIPConfiguration {
+	results := make([]network.FrontendIPConfiguration, 0)
+	for _, item := range input {
+		results = append(results, network.FrontendIPConfiguration{
+			ID: utils.String(item.(string)),
+		})
+	}
+	return &results
+}
+
+func flattenArmPrivateLinkServiceFrontendIPConfigurations(input *[]network.FrontendIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+	return results
+}
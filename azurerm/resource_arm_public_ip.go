@@ -7,9 +7,10 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -126,6 +127,13 @@ func resourceArmPublicIp() *schema.Resource {
 				Computed: true,
 			},
 
+			"public_ip_prefix_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"zones": singleZonesSchema(),
 
 			"tags": tagsSchema(),
@@ -169,6 +177,11 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	publicIpPrefixId := d.Get("public_ip_prefix_id").(string)
+	if publicIpPrefixId != "" && !strings.EqualFold(sku, "standard") {
+		return fmt.Errorf("`public_ip_prefix_id` can only be specified for Standard SKU public IP addresses.")
+	}
+
 	if requireResourcesToBeImported {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
@@ -197,6 +210,12 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Zones: zones,
 	}
 
+	if publicIpPrefixId != "" {
+		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &network.SubResource{
+			ID: utils.String(publicIpPrefixId),
+		}
+	}
+
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
@@ -281,6 +300,12 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		publicIpPrefixId := ""
+		if prefix := props.PublicIPPrefix; prefix != nil && prefix.ID != nil {
+			publicIpPrefixId = *prefix.ID
+		}
+		d.Set("public_ip_prefix_id", publicIpPrefixId)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
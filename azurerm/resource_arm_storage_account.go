@@ -7,15 +7,19 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 const blobStorageAccountDefaultAccessTier = "Hot"
 
+var storageAccountResourceName = "azurerm_storage_account"
+
 func resourceArmStorageAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageAccountCreate,
@@ -29,6 +33,8 @@ func resourceArmStorageAccount() *schema.Resource {
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		CustomizeDiff: resourceArmStorageAccountCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -96,10 +102,11 @@ func resourceArmStorageAccount() *schema.Resource {
 				}, true),
 			},
 
+			// Computed since the `azurerm_storage_account_customer_managed_key` resource switches this to `Microsoft.Keyvault`
 			"account_encryption_source": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  string(storage.MicrosoftStorage),
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(storage.MicrosoftKeyvault),
 					string(storage.MicrosoftStorage),
@@ -290,6 +297,110 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"default_service_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`),
+								"The `default_service_version` must be a Storage API version in the format `YYYY-MM-DD`",
+							),
+						},
+					},
+				},
+			},
+
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": storageAccountCorsRuleSchema(),
+
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"delete": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"read": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"write": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"retention_policy_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"hour_metrics": storageAccountQueueMetricsSchema(),
+
+						"minute_metrics": storageAccountQueueMetricsSchema(),
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:         schema.TypeMap,
 				Optional:     true,
@@ -354,6 +465,9 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	replicationType := d.Get("account_replication_type").(string)
 	storageType := fmt.Sprintf("%s_%s", accountTier, replicationType)
 	storageAccountEncryptionSource := d.Get("account_encryption_source").(string)
+	if storageAccountEncryptionSource == "" {
+		storageAccountEncryptionSource = string(storage.MicrosoftStorage)
+	}
 
 	networkRules := expandStorageAccountNetworkRules(d)
 
@@ -429,6 +543,11 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	// the Service Properties can only be configured through the data-plane API once the account exists
+	if err := updateStorageAccountServiceProperties(d, meta, resourceGroupName, storageAccountName); err != nil {
+		return err
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -445,6 +564,9 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	storageAccountName := id.Path["storageAccounts"]
 	resourceGroupName := id.ResourceGroup
 
+	azureRMLockByName(storageAccountName, storageAccountResourceName)
+	defer azureRMUnlockByName(storageAccountName, storageAccountResourceName)
+
 	accountTier := d.Get("account_tier").(string)
 	replicationType := d.Get("account_replication_type").(string)
 	storageType := fmt.Sprintf("%s_%s", accountTier, replicationType)
@@ -516,6 +638,19 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
+		// the Key Vault properties are managed by the `azurerm_storage_account_customer_managed_key` resource
+		// but have to be sent alongside the Key Source, otherwise the request is rejected
+		if strings.EqualFold(encryptionSource, string(storage.MicrosoftKeyvault)) {
+			existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+			}
+
+			if props := existing.AccountProperties; props != nil && props.Encryption != nil {
+				opts.Encryption.KeyVaultProperties = props.Encryption.KeyVaultProperties
+			}
+		}
+
 		if d.HasChange("enable_blob_encryption") {
 			enableEncryption := d.Get("enable_blob_encryption").(bool)
 			opts.Encryption.Services.Blob = &storage.EncryptionService{
@@ -596,6 +731,13 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	if err := updateStorageAccountServiceProperties(d, meta, resourceGroupName, storageAccountName); err != nil {
+		return err
+	}
+	d.SetPartial("blob_properties")
+	d.SetPartial("queue_properties")
+	d.SetPartial("static_website")
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
@@ -727,6 +869,10 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if err := readStorageAccountServiceProperties(d, meta, resGroup, name); err != nil {
+		return err
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -743,6 +889,9 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	name := id.Path["storageAccounts"]
 	resourceGroup := id.ResourceGroup
 
+	azureRMLockByName(name, storageAccountResourceName)
+	defer azureRMUnlockByName(name, storageAccountResourceName)
+
 	read, err := client.GetProperties(ctx, resourceGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -977,3 +1126,490 @@ func flattenAzureRmStorageAccountIdentity(identity *storage.Identity) []interfac
 
 	return []interface{}{result}
 }
+
+func storageAccountCorsRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"allowed_methods": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"POST",
+							"OPTIONS",
+							"PUT",
+						}, false),
+					},
+				},
+				"allowed_origins": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"exposed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 2000000000),
+				},
+			},
+		},
+	}
+}
+
+func storageAccountQueueMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	accountKind := d.Get("account_kind").(string)
+
+	if staticWebsite := d.Get("static_website").([]interface{}); len(staticWebsite) > 0 && accountKind != string(storage.StorageV2) {
+		return fmt.Errorf("`static_website` can only be configured when `account_kind` is set to `StorageV2`")
+	}
+
+	if queueProperties := d.Get("queue_properties").([]interface{}); len(queueProperties) > 0 && accountKind == string(storage.BlobStorage) {
+		return fmt.Errorf("`queue_properties` cannot be configured when `account_kind` is set to `BlobStorage`")
+	}
+
+	return nil
+}
+
+func updateStorageAccountServiceProperties(d *schema.ResourceData, meta interface{}, resourceGroupName, storageAccountName string) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	if d.HasChange("blob_properties") || d.HasChange("static_website") {
+		blobClient, accountExists, err := meta.(*ArmClient).getBlobServicePropertiesClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Blob Service Properties Client for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+		}
+
+		props := expandStorageAccountBlobServiceProperties(d)
+		if _, err := blobClient.SetServiceProperties(ctx, props); err != nil {
+			return fmt.Errorf("Error updating Blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+	}
+
+	if d.HasChange("queue_properties") {
+		queueClient, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Queue Service Client for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+		}
+
+		props := expandStorageAccountQueueServiceProperties(d.Get("queue_properties").([]interface{}))
+		if err := queueClient.SetServiceProperties(props); err != nil {
+			return fmt.Errorf("Error updating Queue Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+	}
+
+	return nil
+}
+
+// readStorageAccountServiceProperties only retrieves the Service Properties for the blocks which are configured, since
+// the data-plane API isn't reachable when the Storage Account is locked down using Network Rules
+func readStorageAccountServiceProperties(d *schema.ResourceData, meta interface{}, resourceGroupName, storageAccountName string) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	blobProperties := d.Get("blob_properties").([]interface{})
+	staticWebsite := d.Get("static_website").([]interface{})
+	if len(blobProperties) > 0 || len(staticWebsite) > 0 {
+		blobClient, accountExists, err := meta.(*ArmClient).getBlobServicePropertiesClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Blob Service Properties Client for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+		}
+
+		props, err := blobClient.GetServiceProperties(ctx)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+
+		if len(blobProperties) > 0 {
+			if err := d.Set("blob_properties", flattenStorageAccountBlobServiceProperties(props)); err != nil {
+				return fmt.Errorf("Error setting `blob_properties`: %+v", err)
+			}
+		}
+
+		if len(staticWebsite) > 0 {
+			if err := d.Set("static_website", flattenStorageAccountStaticWebsite(props.StaticWebsite)); err != nil {
+				return fmt.Errorf("Error setting `static_website`: %+v", err)
+			}
+		}
+	}
+
+	if queueProperties := d.Get("queue_properties").([]interface{}); len(queueProperties) > 0 {
+		queueClient, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return fmt.Errorf("Error building Queue Service Client for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+		if !accountExists {
+			return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+		}
+
+		props, err := queueClient.GetServiceProperties()
+		if err != nil {
+			return fmt.Errorf("Error retrieving Queue Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+		}
+
+		if err := d.Set("queue_properties", flattenStorageAccountQueueServiceProperties(props)); err != nil {
+			return fmt.Errorf("Error setting `queue_properties`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// expandStorageAccountBlobServiceProperties only includes the elements which have changed, since any which are omitted
+// are left as-is by the API - removing a block disables the corresponding feature
+func expandStorageAccountBlobServiceProperties(d *schema.ResourceData) storageBlobServiceProperties {
+	props := storageBlobServiceProperties{}
+
+	if d.HasChange("blob_properties") {
+		props.Cors = &mainStorage.Cors{
+			CorsRule: []mainStorage.CorsRule{},
+		}
+		props.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+			Enabled: false,
+		}
+
+		if blobProperties := d.Get("blob_properties").([]interface{}); len(blobProperties) > 0 && blobProperties[0] != nil {
+			v := blobProperties[0].(map[string]interface{})
+
+			props.Cors = expandStorageAccountCorsRules(v["cors_rule"].([]interface{}))
+
+			if policies := v["delete_retention_policy"].([]interface{}); len(policies) > 0 {
+				days := 7
+				if policies[0] != nil {
+					days = policies[0].(map[string]interface{})["days"].(int)
+				}
+				props.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+					Enabled: true,
+					Days:    utils.Int(days),
+				}
+			}
+
+			if version := v["default_service_version"].(string); version != "" {
+				props.DefaultServiceVersion = utils.String(version)
+			}
+		}
+	}
+
+	if d.HasChange("static_website") {
+		props.StaticWebsite = &storageStaticWebsite{
+			Enabled: false,
+		}
+
+		if staticWebsite := d.Get("static_website").([]interface{}); len(staticWebsite) > 0 {
+			props.StaticWebsite.Enabled = true
+
+			if staticWebsite[0] != nil {
+				v := staticWebsite[0].(map[string]interface{})
+				if indexDocument := v["index_document"].(string); indexDocument != "" {
+					props.StaticWebsite.IndexDocument = utils.String(indexDocument)
+				}
+				if errorDocument := v["error_404_document"].(string); errorDocument != "" {
+					props.StaticWebsite.ErrorDocument404Path = utils.String(errorDocument)
+				}
+			}
+		}
+	}
+
+	return props
+}
+
+func flattenStorageAccountBlobServiceProperties(input storageBlobServiceProperties) []interface{} {
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled {
+		days := 0
+		if policy.Days != nil {
+			days = *policy.Days
+		}
+		deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	defaultServiceVersion := ""
+	if input.DefaultServiceVersion != nil {
+		defaultServiceVersion = *input.DefaultServiceVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":               flattenStorageAccountCorsRules(input.Cors),
+			"delete_retention_policy": deleteRetentionPolicies,
+			"default_service_version": defaultServiceVersion,
+		},
+	}
+}
+
+func flattenStorageAccountStaticWebsite(input *storageStaticWebsite) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	indexDocument := ""
+	if input.IndexDocument != nil {
+		indexDocument = *input.IndexDocument
+	}
+
+	errorDocument := ""
+	if input.ErrorDocument404Path != nil {
+		errorDocument = *input.ErrorDocument404Path
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     indexDocument,
+			"error_404_document": errorDocument,
+		},
+	}
+}
+
+// expandStorageAccountQueueServiceProperties always returns each element, so that removing a block disables it
+func expandStorageAccountQueueServiceProperties(input []interface{}) mainStorage.ServiceProperties {
+	props := mainStorage.ServiceProperties{
+		Cors: &mainStorage.Cors{
+			CorsRule: []mainStorage.CorsRule{},
+		},
+		Logging: &mainStorage.Logging{
+			Version: "1.0",
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+		HourMetrics:   expandStorageAccountQueueMetrics(nil),
+		MinuteMetrics: expandStorageAccountQueueMetrics(nil),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return props
+	}
+
+	v := input[0].(map[string]interface{})
+	props.Cors = expandStorageAccountCorsRules(v["cors_rule"].([]interface{}))
+	props.HourMetrics = expandStorageAccountQueueMetrics(v["hour_metrics"].([]interface{}))
+	props.MinuteMetrics = expandStorageAccountQueueMetrics(v["minute_metrics"].([]interface{}))
+
+	if logging := v["logging"].([]interface{}); len(logging) > 0 && logging[0] != nil {
+		l := logging[0].(map[string]interface{})
+		props.Logging = &mainStorage.Logging{
+			Version:         l["version"].(string),
+			Delete:          l["delete"].(bool),
+			Read:            l["read"].(bool),
+			Write:           l["write"].(bool),
+			RetentionPolicy: expandStorageAccountRetentionPolicy(l["retention_policy_days"].(int)),
+		}
+	}
+
+	return props
+}
+
+func expandStorageAccountQueueMetrics(input []interface{}) *mainStorage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return &mainStorage.Metrics{
+			Version: "1.0",
+			Enabled: false,
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	metrics := &mainStorage.Metrics{
+		Version:         v["version"].(string),
+		Enabled:         v["enabled"].(bool),
+		RetentionPolicy: expandStorageAccountRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+
+	// `IncludeAPIs` must only be specified when the Metrics are enabled
+	if metrics.Enabled {
+		metrics.IncludeAPIs = utils.Bool(v["include_apis"].(bool))
+	}
+
+	return metrics
+}
+
+func expandStorageAccountRetentionPolicy(days int) *mainStorage.RetentionPolicy {
+	if days == 0 {
+		return &mainStorage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &mainStorage.RetentionPolicy{
+		Enabled: true,
+		Days:    utils.Int(days),
+	}
+}
+
+func flattenStorageAccountQueueServiceProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	logging := make([]interface{}, 0)
+	if l := input.Logging; l != nil && (l.Delete || l.Read || l.Write) {
+		logging = append(logging, map[string]interface{}{
+			"version":               l.Version,
+			"delete":                l.Delete,
+			"read":                  l.Read,
+			"write":                 l.Write,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(l.RetentionPolicy),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      flattenStorageAccountCorsRules(input.Cors),
+			"logging":        logging,
+			"hour_metrics":   flattenStorageAccountQueueMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageAccountQueueMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+func flattenStorageAccountQueueMetrics(input *mainStorage.Metrics) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+func flattenStorageAccountRetentionPolicy(input *mainStorage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func expandStorageAccountCorsRules(input []interface{}) *mainStorage.Cors {
+	rules := make([]mainStorage.CorsRule, 0)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, mainStorage.CorsRule{
+			AllowedHeaders:  strings.Join(*utils.ExpandStringArray(v["allowed_headers"].([]interface{})), ","),
+			AllowedMethods:  strings.Join(*utils.ExpandStringArray(v["allowed_methods"].([]interface{})), ","),
+			AllowedOrigins:  strings.Join(*utils.ExpandStringArray(v["allowed_origins"].([]interface{})), ","),
+			ExposedHeaders:  strings.Join(*utils.ExpandStringArray(v["exposed_headers"].([]interface{})), ","),
+			MaxAgeInSeconds: v["max_age_in_seconds"].(int),
+		})
+	}
+
+	return &mainStorage.Cors{
+		CorsRule: rules,
+	}
+}
+
+func flattenStorageAccountCorsRules(input *mainStorage.Cors) []interface{} {
+	rules := make([]interface{}, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, rule := range input.CorsRule {
+		rules = append(rules, map[string]interface{}{
+			"allowed_headers":    flattenStorageAccountCorsRuleValues(rule.AllowedHeaders),
+			"allowed_methods":    flattenStorageAccountCorsRuleValues(rule.AllowedMethods),
+			"allowed_origins":    flattenStorageAccountCorsRuleValues(rule.AllowedOrigins),
+			"exposed_headers":    flattenStorageAccountCorsRuleValues(rule.ExposedHeaders),
+			"max_age_in_seconds": rule.MaxAgeInSeconds,
+		})
+	}
+
+	return rules
+}
+
+func flattenStorageAccountCorsRuleValues(input string) []interface{} {
+	values := make([]interface{}, 0)
+	if input == "" {
+		return values
+	}
+
+	for _, v := range strings.Split(input, ",") {
+		values = append(values, strings.TrimSpace(v))
+	}
+
+	return values
+}
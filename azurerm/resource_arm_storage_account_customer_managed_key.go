@@ -0,0 +1,314 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Read:   resourceArmStorageAccountCustomerManagedKeyRead,
+		Update: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Delete: resourceArmStorageAccountCustomerManagedKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Storage", "storageAccounts"),
+			},
+
+			// when the Key ID doesn't include a Version the latest version of the Key is used,
+			// which allows the Key to be rotated without needing to update the Storage Account
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateKeyVaultChildIdVersionOptional,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	vaultsClient := meta.(*ArmClient).keyVaultClient
+	keysClient := meta.(*ArmClient).keyVaultManagementClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := parseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	azureRMLockByName(accountName, storageAccountResourceName)
+	defer azureRMUnlockByName(accountName, storageAccountResourceName)
+
+	account, err := client.GetProperties(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+	if account.ID == nil {
+		return fmt.Errorf("Cannot read ID for Storage Account %q (Resource Group %q)", accountName, resourceGroup)
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if storageAccountUsesCustomerManagedKey(account.AccountProperties) {
+			return tf.ImportAsExistsError("azurerm_storage_account_customer_managed_key", *account.ID)
+		}
+	}
+
+	tenantId, principalId, err := validateStorageAccountCustomerManagedKeyIdentity(account.Identity)
+	if err != nil {
+		return fmt.Errorf("Error validating Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	keyId, err := azure.ParseKeyVaultChildIDVersionOptional(d.Get("key_vault_key_id").(string))
+	if err != nil {
+		return err
+	}
+	vaultUri := keyId.KeyVaultBaseUrl
+	keyName := keyId.Name
+	keyVersion := keyId.Version
+
+	// the Key Vault's Access Policies are needed to confirm the Storage Account can use the Key
+	keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultsClient, vaultUri)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Resource ID for the Key Vault at URL %q: %+v", vaultUri, err)
+	}
+	if keyVaultId == nil {
+		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", vaultUri)
+	}
+
+	vaultId, err := parseAzureResourceID(*keyVaultId)
+	if err != nil {
+		return err
+	}
+	vaultResourceGroup := vaultId.ResourceGroup
+	vaultName := vaultId.Path["vaults"]
+
+	vault, err := vaultsClient.Get(ctx, vaultResourceGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, vaultResourceGroup, err)
+	}
+	if vault.Properties == nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): `properties` was nil", vaultName, vaultResourceGroup)
+	}
+
+	if err := validateStorageAccountCustomerManagedKeyAccess(vault.Properties.AccessPolicies, tenantId, principalId); err != nil {
+		return fmt.Errorf("Error validating access to Key Vault %q (Resource Group %q) for Storage Account %q (Resource Group %q): %+v", vaultName, vaultResourceGroup, accountName, resourceGroup, err)
+	}
+
+	// this both confirms the Key (and Version) exists and that it can be used by the Storage Account
+	key, err := keysClient.GetKey(ctx, vaultUri, keyName, keyVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(key.Response) {
+			return fmt.Errorf("Key %q (Version %q) was not found in Key Vault %q (Resource Group %q)", keyName, keyVersion, vaultName, vaultResourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Key %q (Version %q) from Key Vault %q (Resource Group %q): %+v", keyName, keyVersion, vaultName, vaultResourceGroup, err)
+	}
+
+	// the Encryption block is sent as a whole, so the existing Services need to be included
+	// otherwise encryption would be disabled for them
+	encryption := &storage.Encryption{
+		KeySource: storage.MicrosoftKeyvault,
+		KeyVaultProperties: &storage.KeyVaultProperties{
+			KeyName:     utils.String(keyName),
+			KeyVaultURI: utils.String(vaultUri),
+		},
+	}
+	if keyVersion != "" {
+		encryption.KeyVaultProperties.KeyVersion = utils.String(keyVersion)
+	}
+	if props := account.AccountProperties; props != nil && props.Encryption != nil {
+		encryption.Services = props.Encryption.Services
+	}
+
+	parameters := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: encryption,
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, accountName, parameters); err != nil {
+		return fmt.Errorf("Error updating Customer Managed Key for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	d.SetId(*account.ID)
+
+	return resourceArmStorageAccountCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	resp, err := client.GetProperties(ctx, resourceGroup, accountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Storage Account %q was not found in Resource Group %q - removing from state!", accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if !storageAccountUsesCustomerManagedKey(resp.AccountProperties) {
+		log.Printf("[DEBUG] Storage Account %q (Resource Group %q) isn't using a Customer Managed Key - removing from state!", accountName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", d.Id())
+
+	d.Set("key_vault_key_id", flattenStorageAccountCustomerManagedKeyID(resp.AccountProperties.Encryption.KeyVaultProperties))
+
+	return nil
+}
+
+func resourceArmStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageServiceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	azureRMLockByName(accountName, storageAccountResourceName)
+	defer azureRMUnlockByName(accountName, storageAccountResourceName)
+
+	account, err := client.GetProperties(ctx, resourceGroup, accountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	// there's no way to remove a Customer Managed Key, instead we switch back to Microsoft Managed Keys
+	encryption := &storage.Encryption{
+		KeySource: storage.MicrosoftStorage,
+	}
+	if props := account.AccountProperties; props != nil && props.Encryption != nil {
+		encryption.Services = props.Encryption.Services
+	}
+
+	parameters := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: encryption,
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, accountName, parameters); err != nil {
+		return fmt.Errorf("Error removing Customer Managed Key from Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// flattenStorageAccountCustomerManagedKeyID builds the Key Vault Key ID from the Key Vault properties,
+// which only includes the Version when the Storage Account is pinned to a specific version of the Key
+func flattenStorageAccountCustomerManagedKeyID(input *storage.KeyVaultProperties) string {
+	if input == nil || input.KeyVaultURI == nil || input.KeyName == nil {
+		return ""
+	}
+
+	keyId := fmt.Sprintf("%s/keys/%s", strings.TrimSuffix(*input.KeyVaultURI, "/"), *input.KeyName)
+	if input.KeyVersion != nil && *input.KeyVersion != "" {
+		keyId = fmt.Sprintf("%s/%s", keyId, *input.KeyVersion)
+	}
+
+	return keyId
+}
+
+func storageAccountUsesCustomerManagedKey(props *storage.AccountProperties) bool {
+	if props == nil || props.Encryption == nil || props.Encryption.KeyVaultProperties == nil {
+		return false
+	}
+
+	return strings.EqualFold(string(props.Encryption.KeySource), string(storage.MicrosoftKeyvault))
+}
+
+// validateStorageAccountCustomerManagedKeyIdentity confirms the Storage Account has a System Assigned Identity
+// (which is used to access the Key Vault) and returns the Tenant ID and Principal ID for it
+func validateStorageAccountCustomerManagedKeyIdentity(identity *storage.Identity) (string, string, error) {
+	if identity == nil || identity.Type == nil || !strings.EqualFold(*identity.Type, "SystemAssigned") {
+		return "", "", fmt.Errorf("a Customer Managed Key requires the Storage Account to have a `SystemAssigned` identity")
+	}
+
+	if identity.TenantID == nil || *identity.TenantID == "" || identity.PrincipalID == nil || *identity.PrincipalID == "" {
+		return "", "", fmt.Errorf("the Tenant ID and Principal ID for the Storage Account's `SystemAssigned` identity were empty")
+	}
+
+	return *identity.TenantID, *identity.PrincipalID, nil
+}
+
+// validateStorageAccountCustomerManagedKeyAccess confirms an Access Policy exists which allows the
+// Storage Account's identity to get, wrap and unwrap the Key
+func validateStorageAccountCustomerManagedKeyAccess(policies *[]keyvault.AccessPolicyEntry, tenantId string, principalId string) error {
+	required := []keyvault.KeyPermissions{
+		keyvault.KeyPermissionsGet,
+		keyvault.KeyPermissionsUnwrapKey,
+		keyvault.KeyPermissionsWrapKey,
+	}
+
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.ObjectID == nil || !strings.EqualFold(*policy.ObjectID, principalId) {
+				continue
+			}
+			if policy.TenantID == nil || !strings.EqualFold(policy.TenantID.String(), tenantId) {
+				continue
+			}
+
+			granted := make(map[string]bool)
+			if policy.Permissions != nil && policy.Permissions.Keys != nil {
+				for _, permission := range *policy.Permissions.Keys {
+					granted[strings.ToLower(string(permission))] = true
+				}
+			}
+
+			missing := make([]string, 0)
+			for _, permission := range required {
+				if !granted[strings.ToLower(string(permission))] {
+					missing = append(missing, string(permission))
+				}
+			}
+
+			if len(missing) > 0 {
+				return fmt.Errorf("the Access Policy for the Storage Account's identity (Object ID %q) is missing the Key Permissions: %s", principalId, strings.Join(missing, ", "))
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no Access Policy exists for the Storage Account's identity (Object ID %q) - this requires the Key Permissions `get`, `unwrapKey` and `wrapKey`", principalId)
+}
@@ -0,0 +1,453 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenStorageAccountCustomerManagedKeyID(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *storage.KeyVaultProperties
+		Expected string
+	}{
+		{
+			Name:     "No Properties",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name: "Versioned",
+			Input: &storage.KeyVaultProperties{
+				KeyVaultURI: utils.String("https://example.vault.azure.net/"),
+				KeyName:     utils.String("bird"),
+				KeyVersion:  utils.String("fdf067c93bbb4b22bff4d8b7a9a56217"),
+			},
+			Expected: "https://example.vault.azure.net/keys/bird/fdf067c93bbb4b22bff4d8b7a9a56217",
+		},
+		{
+			Name: "Versionless",
+			Input: &storage.KeyVaultProperties{
+				KeyVaultURI: utils.String("https://example.vault.azure.net"),
+				KeyName:     utils.String("bird"),
+				KeyVersion:  utils.String(""),
+			},
+			Expected: "https://example.vault.azure.net/keys/bird",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenStorageAccountCustomerManagedKeyID(tc.Input)
+		if actual != tc.Expected {
+			t.Fatalf("Expected the Key Vault Key ID to be %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestValidateStorageAccountCustomerManagedKeyIdentity(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Identity      *storage.Identity
+		ErrorContains string
+	}{
+		{
+			Name:          "No Identity",
+			Identity:      nil,
+			ErrorContains: "requires the Storage Account to have a `SystemAssigned` identity",
+		},
+		{
+			Name: "Other Identity Type",
+			Identity: &storage.Identity{
+				Type: utils.String("UserAssigned"),
+			},
+			ErrorContains: "requires the Storage Account to have a `SystemAssigned` identity",
+		},
+		{
+			Name: "Missing Principal ID",
+			Identity: &storage.Identity{
+				Type:     utils.String("SystemAssigned"),
+				TenantID: utils.String("00000000-0000-0000-0000-000000000000"),
+			},
+			ErrorContains: "were empty",
+		},
+		{
+			Name: "System Assigned",
+			Identity: &storage.Identity{
+				Type:        utils.String("systemassigned"),
+				TenantID:    utils.String("00000000-0000-0000-0000-000000000000"),
+				PrincipalID: utils.String("11111111-1111-1111-1111-111111111111"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		tenantId, principalId, err := validateStorageAccountCustomerManagedKeyIdentity(tc.Identity)
+		if tc.ErrorContains != "" {
+			if err == nil {
+				t.Fatalf("Expected an error containing %q but got none", tc.ErrorContains)
+			}
+			if !strings.Contains(err.Error(), tc.ErrorContains) {
+				t.Fatalf("Expected an error containing %q but got %q", tc.ErrorContains, err.Error())
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if tenantId != *tc.Identity.TenantID {
+			t.Fatalf("Expected the Tenant ID to be %q but got %q", *tc.Identity.TenantID, tenantId)
+		}
+		if principalId != *tc.Identity.PrincipalID {
+			t.Fatalf("Expected the Principal ID to be %q but got %q", *tc.Identity.PrincipalID, principalId)
+		}
+	}
+}
+
+func TestValidateStorageAccountCustomerManagedKeyAccess(t *testing.T) {
+	tenantId := "00000000-0000-0000-0000-000000000000"
+	principalId := "11111111-1111-1111-1111-111111111111"
+
+	policy := func(tenant string, objectId string, permissions ...keyvault.KeyPermissions) keyvault.AccessPolicyEntry {
+		tenantUuid := uuid.FromStringOrNil(tenant)
+		return keyvault.AccessPolicyEntry{
+			TenantID: &tenantUuid,
+			ObjectID: utils.String(objectId),
+			Permissions: &keyvault.Permissions{
+				Keys: &permissions,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Policies      *[]keyvault.AccessPolicyEntry
+		ErrorContains string
+	}{
+		{
+			Name:          "No Policies",
+			Policies:      nil,
+			ErrorContains: "no Access Policy exists",
+		},
+		{
+			Name: "Policy for another Object",
+			Policies: &[]keyvault.AccessPolicyEntry{
+				policy(tenantId, "22222222-2222-2222-2222-222222222222", keyvault.KeyPermissionsGet, keyvault.KeyPermissionsWrapKey, keyvault.KeyPermissionsUnwrapKey),
+			},
+			ErrorContains: "no Access Policy exists",
+		},
+		{
+			Name: "Policy in another Tenant",
+			Policies: &[]keyvault.AccessPolicyEntry{
+				policy("33333333-3333-3333-3333-333333333333", principalId, keyvault.KeyPermissionsGet, keyvault.KeyPermissionsWrapKey, keyvault.KeyPermissionsUnwrapKey),
+			},
+			ErrorContains: "no Access Policy exists",
+		},
+		{
+			Name: "Missing Permissions",
+			Policies: &[]keyvault.AccessPolicyEntry{
+				policy(tenantId, principalId, keyvault.KeyPermissionsGet),
+			},
+			ErrorContains: "missing the Key Permissions: unwrapKey, wrapKey",
+		},
+		{
+			Name: "Required Permissions",
+			Policies: &[]keyvault.AccessPolicyEntry{
+				policy(tenantId, "22222222-2222-2222-2222-222222222222", keyvault.KeyPermissionsCreate),
+				policy(tenantId, strings.ToUpper(principalId), keyvault.KeyPermissionsGet, keyvault.KeyPermissions("wrapkey"), keyvault.KeyPermissionsUnwrapKey, keyvault.KeyPermissionsList),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateStorageAccountCustomerManagedKeyAccess(tc.Policies, tenantId, principalId)
+		if tc.ErrorContains != "" {
+			if err == nil {
+				t.Fatalf("Expected an error containing %q but got none", tc.ErrorContains)
+			}
+			if !strings.Contains(err.Error(), tc.ErrorContains) {
+				t.Fatalf("Expected an error containing %q but got %q", tc.ErrorContains, err.Error())
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountCustomerManagedKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountCustomerManagedKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_account_customer_managed_key"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_versionless(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountCustomerManagedKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_versionless(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "key_vault_key_id", regexp.MustCompile("/keys/key-[a-z0-9]+$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		accountName := id.Path["storageAccounts"]
+
+		client := testAccProvider.Meta().(*ArmClient).storageServiceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetProperties(ctx, resourceGroup, accountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Storage Account %q (Resource Group %q) does not exist", accountName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
+		}
+
+		if !storageAccountUsesCustomerManagedKey(resp.AccountProperties) {
+			return fmt.Errorf("Bad: Storage Account %q (Resource Group %q) isn't using a Customer Managed Key", accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageAccountCustomerManagedKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storageServiceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_account_customer_managed_key" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		accountName := id.Path["storageAccounts"]
+
+		resp, err := client.GetProperties(ctx, resourceGroup, accountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		if storageAccountUsesCustomerManagedKey(resp.AccountProperties) {
+			return fmt.Errorf("Storage Account %q (Resource Group %q) is still using a Customer Managed Key", accountName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+  key_permissions = ["create", "delete", "get"]
+}
+
+resource "azurerm_key_vault_access_policy" "storage" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${azurerm_storage_account.test.identity.0.tenant_id}"
+  object_id    = "${azurerm_storage_account.test.identity.0.principal_id}"
+
+  key_permissions = ["get", "unwrapKey", "wrapKey"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.client"]
+}
+`, rInt, location, rString, rString, rString)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_key_id   = "${azurerm_key_vault_key.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.storage"]
+}
+`, template)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "import" {
+  storage_account_id = "${azurerm_storage_account_customer_managed_key.test.storage_account_id}"
+  key_vault_key_id   = "${azurerm_storage_account_customer_managed_key.test.key_vault_key_id}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_versionless(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_key_id   = "${azurerm_key_vault.test.vault_uri}keys/${azurerm_key_vault_key.test.name}"
+
+  depends_on = ["azurerm_key_vault_access_policy.storage"]
+}
+`, template)
+}
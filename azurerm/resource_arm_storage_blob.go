@@ -2,7 +2,7 @@ package azurerm
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -37,6 +37,8 @@ func resourceArmStorageBlob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmStorageBlobCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,7 +64,7 @@ func resourceArmStorageBlob() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"block", "page"}, true),
+				ValidateFunc: validation.StringInSlice([]string{"append", "block", "page"}, true),
 			},
 
 			"size": {
@@ -84,16 +86,41 @@ func resourceArmStorageBlob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 
+			"access_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Archive",
+					"Cool",
+					"Hot",
+				}, false),
+			},
+
+			"metadata": storageMetaDataSchema(),
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -162,6 +189,12 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		if err := blob.Copy(sourceUri, options); err != nil {
 			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 		}
+	} else if d.Get("source").(string) != "" || d.Get("source_content").(string) != "" {
+		// the Blob is created as a part of the upload, since creating an empty Block Blob first would discard any
+		// uncommitted blocks from a previous attempt which can otherwise be resumed
+		if err := resourceArmStorageBlobUploadFromSource(d, blobClient, containerName, name); err != nil {
+			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+		}
 	} else {
 		switch strings.ToLower(blobType) {
 		case "block":
@@ -169,57 +202,225 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 			if err := blob.CreateBlockBlob(options); err != nil {
 				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
+		case "page":
+			size := int64(d.Get("size").(int))
+			options := &storage.PutBlobOptions{}
 
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
-
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
+			blob.Properties.ContentLength = size
+			blob.Properties.ContentType = contentType
+			if err := blob.PutPageBlob(options); err != nil {
+				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
-		case "page":
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
+		case "append":
+			options := &storage.PutBlobOptions{}
 
-				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
-			} else {
-				size := int64(d.Get("size").(int))
-				options := &storage.PutBlobOptions{}
-
-				blob.Properties.ContentLength = size
-				blob.Properties.ContentType = contentType
-				if err := blob.PutPageBlob(options); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
+			blob.Properties.ContentType = contentType
+			if err := blob.PutAppendBlob(options); err != nil {
+				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
 		}
 	}
 
+	if err := resourceArmStorageBlobUpdateMetaDataAndAccessTier(d, meta, blob, resourceGroupName, storageAccountName); err != nil {
+		return err
+	}
+
 	d.SetId(id)
 	return resourceArmStorageBlobRead(d, meta)
 }
 
+func resourceArmStorageBlobCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	if d.HasChange("access_tier") {
+		if accessTier := d.Get("access_tier").(string); accessTier != "" && !strings.EqualFold(d.Get("type").(string), "block") {
+			return fmt.Errorf("`access_tier` can only be set when `type` is `block`")
+		}
+	}
+
+	// the Content MD5 can only be determined ahead of time for content which is uploaded by Terraform
+	if !d.NewValueKnown("source") || !d.NewValueKnown("source_content") {
+		return nil
+	}
+
+	var contentMD5 string
+	if source := d.Get("source").(string); source != "" {
+		file, err := os.Open(source)
+		if err != nil {
+			// the file may not exist until it's created by another resource during the apply
+			log.Printf("[DEBUG] Unable to open source file %q to calculate the Content MD5: %s", source, err)
+			return nil
+		}
+		defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing source file %q after calculating the Content MD5", source))
+
+		contentMD5, err = resourceArmStorageBlobContentMD5(file)
+		if err != nil {
+			return fmt.Errorf("Error calculating the Content MD5 of source file %q: %s", source, err)
+		}
+	} else if content := d.Get("source_content").(string); content != "" {
+		var err error
+		contentMD5, err = resourceArmStorageBlobContentMD5(strings.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("Error calculating the Content MD5 of `source_content`: %s", err)
+		}
+	} else {
+		return nil
+	}
+
+	// this also detects when the Blob has been modified outside of Terraform, since the Content MD5 is read back.
+	// An existing Blob without a Content MD5 (e.g. uploaded before this field was added) can't be compared with the
+	// local content, so it's uploaded once more to set the Content MD5 - after which any drift is detected
+	if existingMD5 := d.Get("content_md5").(string); existingMD5 != contentMD5 {
+		return d.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobUpdateMetaDataAndAccessTier sets the MetaData and Access Tier of the Blob when they've changed,
+// which doesn't require the Blob to be uploaded again
+func resourceArmStorageBlobUpdateMetaDataAndAccessTier(d *schema.ResourceData, meta interface{}, blob *storage.Blob, resourceGroupName, storageAccountName string) error {
+	// uploading the content again replaces the MetaData and Access Tier of the Blob
+	reuploaded := !d.IsNewResource() && d.HasChange("content_md5")
+
+	if d.HasChange("metadata") || reuploaded {
+		blob.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
+
+		options := &storage.SetBlobMetadataOptions{}
+		if err := blob.SetMetadata(options); err != nil {
+			return fmt.Errorf("Error setting metadata of blob %q (container %q, storage account %q): %+v", blob.Name, blob.Container.Name, storageAccountName, err)
+		}
+	}
+
+	accessTier := d.Get("access_tier").(string)
+	if accessTier != "" && (d.HasChange("access_tier") || reuploaded) {
+		armClient := meta.(*ArmClient)
+		tierClient, accountExists, err := armClient.getBlobAccessTierClientForStorageAccount(armClient.StopContext, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		if _, err := tierClient.SetAccessTier(armClient.StopContext, blob.Container.Name, blob.Name, accessTier); err != nil {
+			return fmt.Errorf("Error setting access tier of blob %q (container %q, storage account %q): %+v", blob.Name, blob.Container.Name, storageAccountName, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobUploadFromSource uploads either the `source` file or the `source_content` to the Blob, setting
+// the Content MD5 so that any changes to the content can be detected
+func resourceArmStorageBlobUploadFromSource(d *schema.ResourceData, client *storage.BlobStorageClient, container, name string) error {
+	blobType := d.Get("type").(string)
+	contentType := d.Get("content_type").(string)
+	parallelism := d.Get("parallelism").(int)
+	attempts := d.Get("attempts").(int)
+
+	source := d.Get("source").(string)
+	var reader io.ReaderAt
+	var size int64
+	if source != "" {
+		file, err := os.Open(source)
+		if err != nil {
+			return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
+		}
+		defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error stating source file %q: %s", source, err)
+		}
+
+		reader = file
+		size = info.Size()
+	} else {
+		content := d.Get("source_content").(string)
+		reader = strings.NewReader(content)
+		size = int64(len(content))
+		source = "source_content"
+	}
+
+	contentMD5, err := resourceArmStorageBlobContentMD5(io.NewSectionReader(reader, 0, size))
+	if err != nil {
+		return fmt.Errorf("Error calculating the Content MD5 of %q: %s", source, err)
+	}
+
+	switch strings.ToLower(blobType) {
+	case "append":
+		return resourceArmStorageBlobAppendUploadFromSource(container, name, source, reader, size, contentType, contentMD5, client, attempts)
+	case "block":
+		return resourceArmStorageBlobBlockUploadFromSource(container, name, source, reader, size, contentType, contentMD5, client, parallelism, attempts)
+	case "page":
+		return resourceArmStorageBlobPageUploadFromSource(container, name, source, reader, size, contentType, contentMD5, client, parallelism, attempts)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobContentMD5(reader io.Reader) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resourceArmStorageBlobAppendUploadFromSource uploads the source sequentially, since blocks can only be appended in order
+func resourceArmStorageBlobAppendUploadFromSource(container, name, source string, reader io.ReaderAt, size int64, contentType, contentMD5 string, client *storage.BlobStorageClient, attempts int) error {
+	const blockSize int64 = 4 * 1024 * 1024
+
+	containerReference := client.GetContainerReference(container)
+	blob := containerReference.GetBlobReference(name)
+	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = contentMD5
+
+	options := &storage.PutBlobOptions{}
+	if err := blob.PutAppendBlob(options); err != nil {
+		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+	}
+
+	for offset := int64(0); offset < size; offset += blockSize {
+		chunkSize := blockSize
+		if remainder := size - offset; remainder < blockSize {
+			chunkSize = remainder
+		}
+
+		chunk := make([]byte, chunkSize)
+		if _, err := reader.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return fmt.Errorf("Error reading source file %q at offset %d: %s", source, offset, err)
+		}
+
+		// the append position ensures a retried block isn't appended twice if an earlier attempt succeeded
+		position := uint(offset)
+		var err error
+		for i := 0; i < attempts; i++ {
+			options := &storage.AppendBlockOptions{
+				AppendPosition: &position,
+				ContentMD5:     true,
+			}
+			if err = blob.AppendBlock(chunk, options); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Error appending block at offset %d for source file %q: %s", offset, source, err)
+		}
+	}
+
+	return nil
+}
+
 type resourceArmStorageBlobPage struct {
 	offset  int64
 	section *io.SectionReader
 }
 
-func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobPageUploadFromSource(container, name, source string, reader io.ReaderAt, size int64, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
-	file, err := os.Open(source)
-	if err != nil {
-		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
-	}
-	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
-
-	blobSize, pageList, err := resourceArmStorageBlobPageSplit(file)
+	blobSize, pageList, err := resourceArmStorageBlobPageSplit(reader, size)
 	if err != nil {
 		return fmt.Errorf("Error splitting source file %q into pages: %s", source, err)
 	}
@@ -229,6 +430,7 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 	blob := containerRef.GetBlobReference(name)
 	blob.Properties.ContentLength = blobSize
 	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = contentMD5
 	err = blob.PutPageBlob(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -269,20 +471,15 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 	return nil
 }
 
-func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorageBlobPage, error) {
+func resourceArmStorageBlobPageSplit(reader io.ReaderAt, size int64) (int64, []resourceArmStorageBlobPage, error) {
 	const (
 		minPageSize int64 = 4 * 1024
 		maxPageSize int64 = 4 * 1024 * 1024
 	)
 
-	info, err := file.Stat()
-	if err != nil {
-		return int64(0), nil, fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
-	}
-
-	blobSize := info.Size()
-	if info.Size()%minPageSize != 0 {
-		blobSize = info.Size() + (minPageSize - (info.Size() % minPageSize))
+	blobSize := size
+	if size%minPageSize != 0 {
+		blobSize = size + (minPageSize - (size % minPageSize))
 	}
 
 	emptyPage := make([]byte, minPageSize)
@@ -296,7 +493,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 	var currentRange byteRange
 	for i := int64(0); i < blobSize; i += minPageSize {
 		pageBuf := make([]byte, minPageSize)
-		_, err = file.ReadAt(pageBuf, i)
+		_, err := reader.ReadAt(pageBuf, i)
 		if err != nil && err != io.EOF {
 			return int64(0), nil, fmt.Errorf("Could not read chunk at %d: %s", i, err)
 		}
@@ -323,11 +520,11 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 	for _, nonEmptyRange := range nonEmptyRanges {
 		pages = append(pages, resourceArmStorageBlobPage{
 			offset:  nonEmptyRange.offset,
-			section: io.NewSectionReader(file, nonEmptyRange.offset, nonEmptyRange.length),
+			section: io.NewSectionReader(reader, nonEmptyRange.offset, nonEmptyRange.length),
 		})
 	}
 
-	return info.Size(), pages, nil
+	return size, pages, nil
 }
 
 type resourceArmStorageBlobPageUploadContext struct {
@@ -384,24 +581,27 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 }
 
 type resourceArmStorageBlobBlock struct {
-	section *io.SectionReader
-	id      string
+	section    *io.SectionReader
+	id         string
+	contentMD5 string
 }
 
-func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobBlockUploadFromSource(container, name, source string, reader io.ReaderAt, size int64, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
-	file, err := os.Open(source)
-	if err != nil {
-		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
-	}
-	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
-
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
+	blockList, allParts, err := resourceArmStorageBlobBlockSplit(reader, size)
 	if err != nil {
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
+	containerReference := client.GetContainerReference(container)
+	blobReference := containerReference.GetBlobReference(name)
+
+	// Block IDs are derived from the content of each block, so any blocks which were uploaded by a previous attempt
+	// and are still uncommitted don't need to be uploaded again
+	uncommitted := resourceArmStorageBlobUncommittedBlocks(blobReference)
+	parts := resourceArmStorageBlobBlocksToUpload(allParts, uncommitted)
+
 	wg := &sync.WaitGroup{}
 	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
 	errors := make(chan error, len(parts))
@@ -431,9 +631,8 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
-	containerReference := client.GetContainerReference(container)
-	blobReference := containerReference.GetBlobReference(name)
 	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5 = contentMD5
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -443,48 +642,77 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
-	const (
-		idSize          = 64
-		blockSize int64 = 4 * 1024 * 1024
-	)
+func resourceArmStorageBlobBlockSplit(reader io.ReaderAt, size int64) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
+	const blockSize int64 = 4 * 1024 * 1024
 	var parts []resourceArmStorageBlobBlock
 	var blockList []storage.Block
 
-	info, err := file.Stat()
-	if err != nil {
-		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
-	}
-
-	for i := int64(0); i < info.Size(); i = i + blockSize {
-		entropy := make([]byte, idSize)
-		_, err = rand.Read(entropy)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
-		}
-
+	for i := int64(0); i < size; i = i + blockSize {
 		sectionSize := blockSize
-		remainder := info.Size() - i
+		remainder := size - i
 		if remainder < blockSize {
 			sectionSize = remainder
 		}
 
+		contentMD5, err := resourceArmStorageBlobContentMD5(io.NewSectionReader(reader, i, sectionSize))
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error calculating the Content MD5 of the block at offset %d: %s", i, err)
+		}
+
+		// Block IDs must be the same length for every block in a Blob - the offset is padded and the
+		// (base64 encoded) MD5 is always 24 characters
 		block := storage.Block{
-			ID:     base64.StdEncoding.EncodeToString(entropy),
+			ID:     base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%020d-%s", i, contentMD5))),
 			Status: storage.BlockStatusUncommitted,
 		}
 
 		blockList = append(blockList, block)
 
 		parts = append(parts, resourceArmStorageBlobBlock{
-			id:      block.ID,
-			section: io.NewSectionReader(file, i, sectionSize),
+			id:         block.ID,
+			contentMD5: contentMD5,
+			section:    io.NewSectionReader(reader, i, sectionSize),
 		})
 	}
 
 	return blockList, parts, nil
 }
 
+// resourceArmStorageBlobUncommittedBlocks returns the size of each uncommitted block in the Blob, keyed by Block ID
+func resourceArmStorageBlobUncommittedBlocks(blob *storage.Blob) map[string]int64 {
+	blocks := make(map[string]int64)
+
+	options := &storage.GetBlockListOptions{}
+	resp, err := blob.GetBlockList(storage.BlockListTypeUncommitted, options)
+	if err != nil {
+		// the Blob won't exist when nothing's been uploaded previously
+		log.Printf("[DEBUG] Unable to retrieve the uncommitted blocks for Blob %q - all blocks will be uploaded: %s", blob.Name, err)
+		return blocks
+	}
+
+	for _, block := range resp.UncommittedBlocks {
+		blocks[block.Name] = block.Size
+	}
+
+	return blocks
+}
+
+// resourceArmStorageBlobBlocksToUpload returns the blocks which haven't already been uploaded, where an uncommitted
+// block is only reused when it's the same size as the block being uploaded
+func resourceArmStorageBlobBlocksToUpload(blocks []resourceArmStorageBlobBlock, uncommitted map[string]int64) []resourceArmStorageBlobBlock {
+	parts := make([]resourceArmStorageBlobBlock, 0)
+	for _, p := range blocks {
+		if blockSize, exists := uncommitted[p.id]; exists && blockSize == p.section.Size() {
+			log.Printf("[DEBUG] Block %q has already been uploaded - skipping", p.id)
+			continue
+		}
+
+		parts = append(parts, p)
+	}
+
+	return parts
+}
+
 type resourceArmStorageBlobBlockUploadContext struct {
 	client    *storage.BlobStorageClient
 	container string
@@ -510,7 +738,10 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 		for i := 0; i < ctx.attempts; i++ {
 			container := ctx.client.GetContainerReference(ctx.container)
 			blob := container.GetBlobReference(ctx.name)
-			options := &storage.PutBlockOptions{}
+			// the Content MD5 is verified by the service for each block
+			options := &storage.PutBlockOptions{
+				ContentMD5: block.contentMD5,
+			}
 			if err = blob.PutBlock(block.id, buffer, options); err == nil {
 				break
 			}
@@ -554,17 +785,33 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	container := blobClient.GetContainerReference(id.containerName)
 	blob := container.GetBlobReference(id.blobName)
 
+	if d.HasChange("content_md5") && (d.Get("source").(string) != "" || d.Get("source_content").(string) != "") {
+		log.Printf("[DEBUG] The content of blob %s (container %s, storage account %s) has changed - uploading..", id.blobName, id.containerName, id.storageAccountName)
+		if err := resourceArmStorageBlobUploadFromSource(d, blobClient, id.containerName, id.blobName); err != nil {
+			return fmt.Errorf("Error uploading blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+	}
+
+	if err := resourceArmStorageBlobUpdateMetaDataAndAccessTier(d, meta, blob, *resourceGroup, id.storageAccountName); err != nil {
+		return err
+	}
+
 	if d.HasChange("content_type") {
+		// Set Blob Properties clears any properties which aren't specified (including the Content MD5),
+		// so the existing properties need to be retrieved first
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+
 		blob.Properties.ContentType = d.Get("content_type").(string)
-	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		options := &storage.SetBlobPropertiesOptions{}
+		if err := blob.SetProperties(options); err != nil {
+			return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
@@ -621,6 +868,44 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resourceGroup)
 
 	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("content_md5", blob.Properties.ContentMD5)
+
+	metaDataOptions := &storage.GetBlobMetadataOptions{}
+	if err := blob.GetMetadata(metaDataOptions); err != nil {
+		return fmt.Errorf("Error getting metadata of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	}
+	if err := d.Set("metadata", flattenStorageMetaData(blob.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
+	// Access Tiers are only available for Block Blobs
+	if blob.Properties.BlobType == storage.BlobTypeBlock {
+		tierClient, accountExists, err := armClient.getBlobAccessTierClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			log.Printf("[DEBUG] Storage account %q not found, removing blob %q from state", id.storageAccountName, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		tier, err := tierClient.GetAccessTier(ctx, id.containerName, id.blobName)
+		if err != nil {
+			if utils.ResponseWasNotFound(tier.Response) {
+				log.Printf("[INFO] Storage blob %q no longer exists, removing from state...", id.blobName)
+				d.SetId("")
+				return nil
+			}
+
+			// not every kind of Storage Account supports Access Tiers (e.g. Premium) - so this isn't fatal
+			log.Printf("[DEBUG] Unable to retrieve the access tier of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		} else {
+			// whilst the Blob is being rehydrated from the Archive tier this is the tier it's being moved to,
+			// so that the change isn't requested again
+			d.Set("access_tier", tier.targetAccessTier())
+		}
+	}
 
 	d.Set("source_uri", blob.Properties.CopySource)
 
@@ -1,21 +1,273 @@
 package azurerm
 
 import (
+	"bytes"
+	"crypto/md5"
 	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"os"
 	"testing"
 
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestStorageBlobBlockSplit(t *testing.T) {
+	content := make([]byte, 9*1024*1024)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("Error generating content: %+v", err)
+	}
+
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(blockList) != 3 || len(parts) != 3 {
+		t.Fatalf("Expected 3 blocks but got %d blocks and %d parts", len(blockList), len(parts))
+	}
+
+	for i, part := range parts {
+		if len(part.id) != len(parts[0].id) {
+			t.Fatalf("Expected every Block ID to be the same length but %q and %q differ", part.id, parts[0].id)
+		}
+
+		if blockList[i].ID != part.id {
+			t.Fatalf("Expected the Block List to contain %q at position %d but got %q", part.id, i, blockList[i].ID)
+		}
+
+		offset := int64(i) * 4 * 1024 * 1024
+		hash := md5.Sum(content[offset : offset+part.section.Size()])
+		if expected := base64.StdEncoding.EncodeToString(hash[:]); part.contentMD5 != expected {
+			t.Fatalf("Expected the Content MD5 of block %d to be %q but got %q", i, expected, part.contentMD5)
+		}
+	}
+
+	if size := parts[2].section.Size(); size != 1024*1024 {
+		t.Fatalf("Expected the last block to be 1MB but got %d bytes", size)
+	}
+
+	// Block IDs need to be stable across attempts so that uncommitted blocks can be reused
+	_, secondParts, err := resourceArmStorageBlobBlockSplit(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	for i := range parts {
+		if parts[i].id != secondParts[i].id {
+			t.Fatalf("Expected the Block ID at position %d to be stable but got %q and %q", i, parts[i].id, secondParts[i].id)
+		}
+	}
+
+	// whereas a change to the content should change the Block ID
+	content[0] = content[0] + 1
+	_, changedParts, err := resourceArmStorageBlobBlockSplit(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if parts[0].id == changedParts[0].id {
+		t.Fatalf("Expected the Block ID to change when the content changes")
+	}
+	if parts[1].id != changedParts[1].id {
+		t.Fatalf("Expected the Block ID of an unchanged block to be stable")
+	}
+}
+
+func TestStorageBlobBlockSplitBlockIDFormat(t *testing.T) {
+	content := make([]byte, 5*1024*1024)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("Error generating content: %+v", err)
+	}
+
+	_, parts, err := resourceArmStorageBlobBlockSplit(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// changing this format means blocks uploaded by an earlier version can't be resumed
+	for i, part := range parts {
+		offset := int64(i) * 4 * 1024 * 1024
+		hash := md5.Sum(content[offset : offset+part.section.Size()])
+		contentMD5 := base64.StdEncoding.EncodeToString(hash[:])
+
+		expected := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%020d-%s", offset, contentMD5)))
+		if part.id != expected {
+			t.Fatalf("Expected the Block ID at position %d to be %q but got %q", i, expected, part.id)
+		}
+	}
+}
+
+type storageBlobRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f storageBlobRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testStorageBlobReference(t *testing.T, statusCode int, body string) *storage.Blob {
+	client, err := storage.NewBasicClient("acctestaccount", base64.StdEncoding.EncodeToString([]byte("key")))
+	if err != nil {
+		t.Fatalf("Error building Storage Client: %+v", err)
+	}
+
+	client.HTTPClient = &http.Client{
+		Transport: storageBlobRoundTripper(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"Content-Type": []string{"application/xml"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	blobClient := client.GetBlobService()
+	return blobClient.GetContainerReference("container").GetBlobReference("blob")
+}
+
+func TestStorageBlobUncommittedBlocksResume(t *testing.T) {
+	content := make([]byte, 9*1024*1024)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("Error generating content: %+v", err)
+	}
+
+	_, parts, err := resourceArmStorageBlobBlockSplit(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// the first block was uploaded by a previous attempt, the second was only partially uploaded
+	body := fmt.Sprintf(`<?xml version="1.0" encoding="utf-8"?>
+<BlockList>
+  <CommittedBlocks />
+  <UncommittedBlocks>
+    <Block><Name>%s</Name><Size>%d</Size></Block>
+    <Block><Name>%s</Name><Size>%d</Size></Block>
+  </UncommittedBlocks>
+</BlockList>`, parts[0].id, parts[0].section.Size(), parts[1].id, parts[1].section.Size()-1)
+
+	uncommitted := resourceArmStorageBlobUncommittedBlocks(testStorageBlobReference(t, http.StatusOK, body))
+	if len(uncommitted) != 2 {
+		t.Fatalf("Expected 2 uncommitted blocks but got %+v", uncommitted)
+	}
+
+	toUpload := resourceArmStorageBlobBlocksToUpload(parts, uncommitted)
+	if len(toUpload) != 2 {
+		t.Fatalf("Expected 2 blocks to be uploaded but got %d", len(toUpload))
+	}
+	if toUpload[0].id != parts[1].id || toUpload[1].id != parts[2].id {
+		t.Fatalf("Expected the second and third blocks to be uploaded but got %q and %q", toUpload[0].id, toUpload[1].id)
+	}
+}
+
+func TestStorageBlobUncommittedBlocksBlobNotFound(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?><Error><Code>BlobNotFound</Code><Message>The specified blob does not exist.</Message></Error>`
+
+	uncommitted := resourceArmStorageBlobUncommittedBlocks(testStorageBlobReference(t, http.StatusNotFound, body))
+	if len(uncommitted) != 0 {
+		t.Fatalf("Expected no uncommitted blocks but got %+v", uncommitted)
+	}
+}
+
+func TestStorageBlobCustomizeDiffContentMD5(t *testing.T) {
+	hash := md5.Sum([]byte("hello world"))
+	contentMD5 := base64.StdEncoding.EncodeToString(hash[:])
+
+	cases := []struct {
+		Name        string
+		StoredMD5   string
+		ExpectedMD5 string
+		ExpectDiff  bool
+	}{
+		{
+			Name:        "No Content MD5",
+			StoredMD5:   "",
+			ExpectedMD5: contentMD5,
+			ExpectDiff:  true,
+		},
+		{
+			Name:       "Unchanged",
+			StoredMD5:  contentMD5,
+			ExpectDiff: false,
+		},
+		{
+			Name:        "Modified Outside of Terraform",
+			StoredMD5:   "AAAAAAAAAAAAAAAAAAAAAA==",
+			ExpectedMD5: contentMD5,
+			ExpectDiff:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		attributes := map[string]string{
+			"name":                   "blob",
+			"resource_group_name":    "group",
+			"storage_account_name":   "account",
+			"storage_container_name": "container",
+			"type":                   "block",
+			"size":                   "0",
+			"content_type":           "application/octet-stream",
+			"source_content":         "hello world",
+			"parallelism":            "8",
+			"attempts":               "1",
+			"access_tier":            "Hot",
+			"metadata.%":             "0",
+		}
+		if tc.StoredMD5 != "" {
+			attributes["content_md5"] = tc.StoredMD5
+		}
+		state := &terraform.InstanceState{
+			ID:         "https://account.blob.core.windows.net/container/blob",
+			Attributes: attributes,
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":                   "blob",
+			"resource_group_name":    "group",
+			"storage_account_name":   "account",
+			"storage_container_name": "container",
+			"type":                   "block",
+			"source_content":         "hello world",
+		})
+		if err != nil {
+			t.Fatalf("Error building config: %+v", err)
+		}
+
+		diff, err := resourceArmStorageBlob().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("Error computing diff: %+v", err)
+		}
+
+		var attr *terraform.ResourceAttrDiff
+		if diff != nil {
+			attr = diff.Attributes["content_md5"]
+		}
+		if !tc.ExpectDiff {
+			if attr != nil {
+				t.Fatalf("Expected no diff for `content_md5` but got %+v", attr)
+			}
+			continue
+		}
+
+		if attr == nil {
+			t.Fatalf("Expected a diff for `content_md5` but got none")
+		}
+		if attr.New != tc.ExpectedMD5 {
+			t.Fatalf("Expected `content_md5` to be %q but got %q", tc.ExpectedMD5, attr.New)
+		}
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -277,6 +529,202 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Hello World"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "sQqNsWTgdUEFt6mb5y4/5Q=="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content"},
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Hello Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "YoIois5ROWUdzguwpnFmlg=="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_sourceChanged(t *testing.T) {
+	resourceName := "azurerm_storage_blob.source"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	if _, err = io.CopyN(sourceBlob, rand.Reader, 5*1024*1024); err != nil {
+		t.Fatalf("Failed to write random test to source blob")
+	}
+
+	if err = sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	config := testAccAzureRMStorageBlobBlock_source(ri, rs, sourceBlob.Name(), testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				),
+			},
+			{
+				PreConfig: func() {
+					// changing the content of the file should cause it to be uploaded again
+					file, err := os.OpenFile(sourceBlob.Name(), os.O_WRONLY|os.O_APPEND, 0600)
+					if err != nil {
+						t.Fatalf("Failed to open source blob: %+v", err)
+					}
+					if _, err := io.CopyN(file, rand.Reader, 1024*1024); err != nil {
+						t.Fatalf("Failed to append to source blob: %+v", err)
+					}
+					if err := file.Close(); err != nil {
+						t.Fatalf("Failed to close source blob: %+v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_metadata(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_metadata(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "sQqNsWTgdUEFt6mb5y4/5Q=="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content"},
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_metadataUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.environment", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "sQqNsWTgdUEFt6mb5y4/5Q=="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_accessTier(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_accessTier(ri, rs, location, "Hot"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Hot"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_accessTier(ri, rs, location, "Cool"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Cool"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "sQqNsWTgdUEFt6mb5y4/5Q=="),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobAppend_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobAppend_sourceContent(ri, rs, location, "Hello World"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "append"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "sQqNsWTgdUEFt6mb5y4/5Q=="),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlobAppend_sourceContent(ri, rs, location, "Hello Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "YoIois5ROWUdzguwpnFmlg=="),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageBlobExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -684,3 +1132,184 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString string, location string, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "greeting.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "%s"
+  content_type           = "text/plain"
+}
+`, rInt, location, rString, content)
+}
+
+func testAccAzureRMStorageBlobBlock_metadata(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "greeting.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "Hello World"
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageBlobBlock_metadataUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "greeting.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "Hello World"
+
+  metadata = {
+    hello       = "terraform"
+    environment = "testing"
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageBlobBlock_accessTier(rInt int, rString string, location string, accessTier string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "greeting.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "Hello World"
+  access_tier            = "%s"
+}
+`, rInt, location, rString, accessTier)
+}
+
+func testAccAzureRMStorageBlobAppend_sourceContent(rInt int, rString string, location string, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "greeting.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "append"
+  source_content         = "%s"
+  content_type           = "text/plain"
+}
+`, rInt, location, rString, content)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,11 +9,14 @@ import (
 	"strings"
 	"time"
 
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -26,6 +30,7 @@ func resourceArmStorageContainer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceArmStorageContainerCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -47,6 +52,37 @@ func resourceArmStorageContainer() *schema.Resource {
 				ValidateFunc: validateArmStorageContainerAccessType,
 			},
 
+			"immutability_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period_since_creation_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"locked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"legal_hold_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArmStorageContainerLegalHoldTag,
+				},
+				Set: schema.HashString,
+			},
+
 			"properties": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -55,6 +91,55 @@ func resourceArmStorageContainer() *schema.Resource {
 	}
 }
 
+// the Storage service normalises Legal Hold tags to lower-case, so only lower-case tags are accepted to avoid a diff
+func validateArmStorageContainerLegalHoldTag(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-z0-9]{3,23}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 3 and 23 lowercase alphanumeric characters: %q", k, value))
+	}
+	return warnings, errors
+}
+
+func resourceArmStorageContainerCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	if !d.HasChange("immutability_policy") {
+		return nil
+	}
+
+	old, new := d.GetChange("immutability_policy")
+	return validateArmStorageContainerImmutabilityPolicyChange(old.([]interface{}), new.([]interface{}))
+}
+
+// validateArmStorageContainerImmutabilityPolicyChange ensures a Locked Immutability Policy is only ever extended,
+// since once locked the policy can't be unlocked, shortened or removed
+func validateArmStorageContainerImmutabilityPolicyChange(old []interface{}, new []interface{}) error {
+	if len(old) == 0 || old[0] == nil {
+		return nil
+	}
+
+	oldPolicy := old[0].(map[string]interface{})
+	if !oldPolicy["locked"].(bool) {
+		return nil
+	}
+
+	if len(new) == 0 || new[0] == nil {
+		return fmt.Errorf("A Locked Immutability Policy cannot be removed from a Storage Container")
+	}
+
+	newPolicy := new[0].(map[string]interface{})
+	if !newPolicy["locked"].(bool) {
+		return fmt.Errorf("A Locked Immutability Policy cannot be unlocked")
+	}
+
+	oldPeriod := oldPolicy["period_since_creation_in_days"].(int)
+	newPeriod := newPolicy["period_since_creation_in_days"].(int)
+	if newPeriod < oldPeriod {
+		return fmt.Errorf("The `period_since_creation_in_days` of a Locked Immutability Policy can only be increased (from %d to %d)", oldPeriod, newPeriod)
+	}
+
+	return nil
+}
+
 //Following the naming convention as laid out in the docs
 func validateArmStorageContainerName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
@@ -139,6 +224,18 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error setting permissions for container %s in storage account %s: %+v", name, storageAccountName, err)
 	}
 
+	if d.HasChange("immutability_policy") {
+		if err := resourceArmStorageContainerUpdateImmutabilityPolicy(ctx, armClient.storageContainersClient, d, resourceGroupName, storageAccountName, name); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("legal_hold_tags") {
+		if err := resourceArmStorageContainerUpdateLegalHold(ctx, armClient.storageContainersClient, d, resourceGroupName, storageAccountName, name); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageContainerRead(d, meta)
 }
@@ -228,6 +325,19 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting `properties`: %+v", err)
 	}
 
+	props, err := armClient.storageContainersClient.Get(ctx, *resourceGroup, id.storageAccountName, id.containerName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Immutability Policy and Legal Hold for container %q (Storage Account %q / Resource Group %q): %+v", id.containerName, id.storageAccountName, *resourceGroup, err)
+	}
+
+	if err := d.Set("immutability_policy", flattenArmStorageContainerImmutabilityPolicy(props.ContainerProperties)); err != nil {
+		return fmt.Errorf("Error setting `immutability_policy`: %+v", err)
+	}
+
+	if err := d.Set("legal_hold_tags", flattenArmStorageContainerLegalHoldTags(props.ContainerProperties)); err != nil {
+		return fmt.Errorf("Error setting `legal_hold_tags`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -260,6 +370,23 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
+	// the Storage service refuses to delete a container with a Locked Immutability Policy or a Legal Hold, however the
+	// error it returns isn't particularly helpful - so we check for these up front
+	props, err := armClient.storageContainersClient.Get(ctx, *resourceGroup, id.storageAccountName, id.containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(props.Response) {
+			return fmt.Errorf("Error retrieving container %q (Storage Account %q / Resource Group %q): %+v", id.containerName, id.storageAccountName, *resourceGroup, err)
+		}
+	} else if container := props.ContainerProperties; container != nil {
+		if policy := container.ImmutabilityPolicy; policy != nil && policy.ImmutabilityPolicyProperty != nil && policy.State == storageMgmt.Locked {
+			return fmt.Errorf("Unable to delete container %q (Storage Account %q / Resource Group %q): the container has a Locked Immutability Policy and can't be deleted until it's empty and the immutability period of all blobs has expired", id.containerName, id.storageAccountName, *resourceGroup)
+		}
+
+		if container.HasLegalHold != nil && *container.HasLegalHold {
+			return fmt.Errorf("Unable to delete container %q (Storage Account %q / Resource Group %q): the container has a Legal Hold - `legal_hold_tags` must be cleared before it can be deleted", id.containerName, id.storageAccountName, *resourceGroup)
+		}
+	}
+
 	log.Printf("[INFO] Deleting storage container %q in account %q", id.containerName, id.storageAccountName)
 	reference := blobClient.GetContainerReference(id.containerName)
 	deleteOptions := &storage.DeleteContainerOptions{}
@@ -270,6 +397,133 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceArmStorageContainerUpdateImmutabilityPolicy(ctx context.Context, client storageMgmt.BlobContainersClient, d *schema.ResourceData, resourceGroup, accountName, containerName string) error {
+	existing, err := client.GetImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error retrieving Immutability Policy for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	etag := ""
+	if existing.Etag != nil {
+		etag = *existing.Etag
+	}
+	locked := existing.ImmutabilityPolicyProperty != nil && existing.State == storageMgmt.Locked
+
+	policies := d.Get("immutability_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		if etag == "" {
+			return nil
+		}
+
+		log.Printf("[INFO] Deleting Immutability Policy for container %q (Storage Account %q)", containerName, accountName)
+		if _, err := client.DeleteImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag); err != nil {
+			return fmt.Errorf("Error deleting Immutability Policy for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	parameters := storageMgmt.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storageMgmt.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(policy["period_since_creation_in_days"].(int))),
+		},
+	}
+
+	// once locked the only change which can be made to a policy is extending the immutability period
+	if locked {
+		log.Printf("[INFO] Extending Immutability Policy for container %q (Storage Account %q)", containerName, accountName)
+		if _, err := client.ExtendImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, etag, &parameters); err != nil {
+			return fmt.Errorf("Error extending Immutability Policy for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+		return nil
+	}
+
+	log.Printf("[INFO] Creating/Updating Immutability Policy for container %q (Storage Account %q)", containerName, accountName)
+	updated, err := client.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, &parameters, etag)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Immutability Policy for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+	}
+
+	if policy["locked"].(bool) {
+		if updated.Etag == nil {
+			return fmt.Errorf("Error locking Immutability Policy for container %q (Storage Account %q / Resource Group %q): `etag` was nil", containerName, accountName, resourceGroup)
+		}
+
+		log.Printf("[INFO] Locking Immutability Policy for container %q (Storage Account %q)", containerName, accountName)
+		if _, err := client.LockImmutabilityPolicy(ctx, resourceGroup, accountName, containerName, *updated.Etag); err != nil {
+			return fmt.Errorf("Error locking Immutability Policy for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageContainerUpdateLegalHold(ctx context.Context, client storageMgmt.BlobContainersClient, d *schema.ResourceData, resourceGroup, accountName, containerName string) error {
+	o, n := d.GetChange("legal_hold_tags")
+	oldTags := o.(*schema.Set)
+	newTags := n.(*schema.Set)
+
+	if removed := oldTags.Difference(newTags); removed.Len() > 0 {
+		legalHold := storageMgmt.LegalHold{
+			Tags: utils.ExpandStringArray(removed.List()),
+		}
+		if _, err := client.ClearLegalHold(ctx, resourceGroup, accountName, containerName, legalHold); err != nil {
+			return fmt.Errorf("Error clearing Legal Hold tags for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	if added := newTags.Difference(oldTags); added.Len() > 0 {
+		legalHold := storageMgmt.LegalHold{
+			Tags: utils.ExpandStringArray(added.List()),
+		}
+		if _, err := client.SetLegalHold(ctx, resourceGroup, accountName, containerName, legalHold); err != nil {
+			return fmt.Errorf("Error setting Legal Hold tags for container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmStorageContainerImmutabilityPolicy(input *storageMgmt.ContainerProperties) []interface{} {
+	if input == nil || input.HasImmutabilityPolicy == nil || !*input.HasImmutabilityPolicy {
+		return []interface{}{}
+	}
+
+	policy := input.ImmutabilityPolicy
+	if policy == nil || policy.ImmutabilityPolicyProperty == nil {
+		return []interface{}{}
+	}
+
+	period := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		period = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"period_since_creation_in_days": period,
+			"locked":                        policy.State == storageMgmt.Locked,
+		},
+	}
+}
+
+func flattenArmStorageContainerLegalHoldTags(input *storageMgmt.ContainerProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.LegalHold == nil || input.LegalHold.Tags == nil {
+		return results
+	}
+
+	for _, tag := range *input.LegalHold.Tags {
+		if tag.Tag != nil {
+			results = append(results, strings.ToLower(*tag.Tag))
+		}
+	}
+
+	return results
+}
+
 func checkContainerIsCreated(reference *storage.Container) func() *resource.RetryError {
 	return func() *resource.RetryError {
 		createOptions := &storage.CreateContainerOptions{}
@@ -165,6 +165,96 @@ func TestAccAzureRMStorageContainer_root(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageContainer_immutabilityPolicy(t *testing.T) {
+	resourceName := "azurerm_storage_container.test"
+	var c storage.Container
+
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	// Locked Immutability Policies can't be removed, so only Unlocked policies are tested here
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageContainer_immutabilityPolicy(ri, rs, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.period_since_creation_in_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.locked", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageContainer_immutabilityPolicy(ri, rs, location, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.0.period_since_creation_in_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageContainer_immutabilityPolicyRemoved(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "immutability_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageContainer_legalHold(t *testing.T) {
+	resourceName := "azurerm_storage_container.test"
+	var c storage.Container
+
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageContainer_legalHold(ri, rs, location, `"case123", "audit2019"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold_tags.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageContainer_legalHold(ri, rs, location, `"case456"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold_tags.#", "1"),
+				),
+			},
+			{
+				// the Legal Hold has to be cleared before the container can be deleted
+				Config: testAccAzureRMStorageContainer_legalHold(ri, rs, location, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageContainerExists(resourceName, &c),
+					resource.TestCheckResourceAttr(resourceName, "legal_hold_tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageContainerExists(resourceName string, c *storage.Container) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -328,6 +418,109 @@ func TestValidateArmStorageContainerName(t *testing.T) {
 	}
 }
 
+func TestValidateArmStorageContainerLegalHoldTag(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "ab", ErrCount: 1},
+		{Value: "abc", ErrCount: 0},
+		{Value: "case123", ErrCount: 0},
+		{Value: "Case123", ErrCount: 1},
+		{Value: "case-123", ErrCount: 1},
+		{Value: strings.Repeat("a", 23), ErrCount: 0},
+		{Value: strings.Repeat("a", 24), ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageContainerLegalHoldTag(tc.Value, "legal_hold_tags")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for Legal Hold Tag %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateArmStorageContainerImmutabilityPolicyChange(t *testing.T) {
+	policy := func(days int, locked bool) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"period_since_creation_in_days": days,
+				"locked":                        locked,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Old           []interface{}
+		New           []interface{}
+		ErrorContains string
+	}{
+		{
+			Name: "New Policy",
+			Old:  []interface{}{},
+			New:  policy(7, true),
+		},
+		{
+			Name: "Unlocked Policy Shortened",
+			Old:  policy(7, false),
+			New:  policy(1, false),
+		},
+		{
+			Name: "Unlocked Policy Removed",
+			Old:  policy(7, false),
+			New:  []interface{}{},
+		},
+		{
+			Name: "Unlocked Policy Locked",
+			Old:  policy(7, false),
+			New:  policy(7, true),
+		},
+		{
+			Name: "Locked Policy Extended",
+			Old:  policy(7, true),
+			New:  policy(14, true),
+		},
+		{
+			Name:          "Locked Policy Shortened",
+			Old:           policy(7, true),
+			New:           policy(1, true),
+			ErrorContains: "can only be increased",
+		},
+		{
+			Name:          "Locked Policy Unlocked",
+			Old:           policy(7, true),
+			New:           policy(7, false),
+			ErrorContains: "cannot be unlocked",
+		},
+		{
+			Name:          "Locked Policy Removed",
+			Old:           policy(7, true),
+			New:           []interface{}{},
+			ErrorContains: "cannot be removed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateArmStorageContainerImmutabilityPolicyChange(tc.Old, tc.New)
+		if tc.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error containing %q but got none", tc.ErrorContains)
+		}
+		if !strings.Contains(err.Error(), tc.ErrorContains) {
+			t.Fatalf("Expected an error containing %q but got: %+v", tc.ErrorContains, err)
+		}
+	}
+}
+
 func testAccAzureRMStorageContainer_basic(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -425,3 +618,83 @@ resource "azurerm_storage_container" "test" {
 }
 `, rInt, location, rString)
 }
+
+func testAccAzureRMStorageContainer_immutabilityPolicy(rInt int, rString string, location string, days int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+
+  immutability_policy {
+    period_since_creation_in_days = %d
+  }
+}
+`, rInt, location, rString, days)
+}
+
+func testAccAzureRMStorageContainer_immutabilityPolicyRemoved(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageContainer_legalHold(rInt int, rString string, location string, tags string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+  legal_hold_tags       = [%s]
+}
+`, rInt, location, rString, tags)
+}
@@ -0,0 +1,533 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	azureStorageManagementPolicyResourceName = "azurerm_storage_management_policy"
+
+	// the version of the Lifecycle Management policy schema supported by the ManagementPoliciesClient
+	// in the 2018-07-01 Storage SDK (which is pinned to the `2018-03-01-preview` API Version)
+	storageManagementPolicyVersion = "0.5"
+)
+
+// the SDK models the Policy as an untyped JSON object, so these are the parts of
+// https://docs.microsoft.com/en-us/azure/storage/blobs/storage-lifecycle-management-concepts which we support
+type storageManagementPolicyDocument struct {
+	Version string                        `json:"version"`
+	Rules   []storageManagementPolicyRule `json:"rules"`
+}
+
+type storageManagementPolicyRule struct {
+	Name       string                                `json:"name"`
+	Type       string                                `json:"type"`
+	Definition storageManagementPolicyRuleDefinition `json:"definition"`
+}
+
+type storageManagementPolicyRuleDefinition struct {
+	Filters *storageManagementPolicyFilters `json:"filters,omitempty"`
+	Actions storageManagementPolicyActions  `json:"actions"`
+}
+
+type storageManagementPolicyFilters struct {
+	BlobTypes   []string `json:"blobTypes,omitempty"`
+	PrefixMatch []string `json:"prefixMatch,omitempty"`
+}
+
+type storageManagementPolicyActions struct {
+	BaseBlob *storageManagementPolicyBaseBlob `json:"baseBlob,omitempty"`
+	Snapshot *storageManagementPolicySnapshot `json:"snapshot,omitempty"`
+}
+
+type storageManagementPolicyBaseBlob struct {
+	TierToCool    *storageManagementPolicyDaysAfterModification `json:"tierToCool,omitempty"`
+	TierToArchive *storageManagementPolicyDaysAfterModification `json:"tierToArchive,omitempty"`
+	Delete        *storageManagementPolicyDaysAfterModification `json:"delete,omitempty"`
+}
+
+type storageManagementPolicySnapshot struct {
+	Delete *storageManagementPolicyDaysAfterCreation `json:"delete,omitempty"`
+}
+
+type storageManagementPolicyDaysAfterModification struct {
+	DaysAfterModificationGreaterThan int `json:"daysAfterModificationGreaterThan"`
+}
+
+type storageManagementPolicyDaysAfterCreation struct {
+	DaysAfterCreationGreaterThan int `json:"daysAfterCreationGreaterThan"`
+}
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmStorageManagementPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Storage", "storageAccounts"),
+			},
+
+			// the API doesn't guarantee the order of the rules, so these are a Set to avoid a diff when they're reordered
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStorageManagementPolicyRuleName,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+										Set: schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCustomizeDiff(d *schema.ResourceDiff, v interface{}) error {
+	rules := d.Get("rule").(*schema.Set).List()
+	return validateStorageManagementPolicyRules(rules)
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storagePolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := parseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, accountName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %s", accountName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError(azureStorageManagementPolicyResourceName, *existing.ID)
+		}
+	}
+
+	policy, err := expandStorageManagementPolicyRules(d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	parameters := storageMgmt.ManagementPoliciesRulesSetParameter{
+		ManagementPoliciesRules: &storageMgmt.ManagementPoliciesRules{
+			Policy: policy,
+		},
+	}
+
+	azureRMLockByName(accountName, azureStorageManagementPolicyResourceName)
+	defer azureRMUnlockByName(accountName, azureStorageManagementPolicyResourceName)
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, accountName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Management Policy for Storage Account %q (Resource Group %q) ID", accountName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storagePolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q was not found in Resource Group %q - removing from state!", accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	storageAccountId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s", id.SubscriptionID, resourceGroup, accountName)
+	d.Set("storage_account_id", storageAccountId)
+
+	if props := resp.AccountManagementPoliciesRulesProperty; props != nil {
+		rules, err := flattenStorageManagementPolicyRules(props.Policy)
+		if err != nil {
+			return fmt.Errorf("Error flattening Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		}
+
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storagePolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["storageAccounts"]
+
+	azureRMLockByName(accountName, azureStorageManagementPolicyResourceName)
+	defer azureRMUnlockByName(accountName, azureStorageManagementPolicyResourceName)
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateStorageManagementPolicyRuleName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]{1,256}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters and can only contain letters and numbers: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+// validateStorageManagementPolicyRules checks the constraints which span multiple fields in each `rule` block
+func validateStorageManagementPolicyRules(input []interface{}) error {
+	names := make(map[string]bool)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		rule := raw.(map[string]interface{})
+
+		// rule names are case-sensitive
+		name := rule["name"].(string)
+		if name != "" {
+			if names[name] {
+				return fmt.Errorf("The name %q is used by more than one `rule` block", name)
+			}
+			names[name] = true
+		}
+
+		actions := rule["actions"].([]interface{})
+		if len(actions) == 0 || actions[0] == nil {
+			return fmt.Errorf("The `rule` %q must specify at least one action within the `actions` block", name)
+		}
+
+		definition := expandStorageManagementPolicyActions(actions)
+		if definition.BaseBlob == nil && definition.Snapshot == nil {
+			return fmt.Errorf("The `rule` %q must specify at least one action within the `actions` block", name)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) (interface{}, error) {
+	document := storageManagementPolicyDocument{
+		Version: storageManagementPolicyVersion,
+		Rules:   make([]storageManagementPolicyRule, 0),
+	}
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		rule := raw.(map[string]interface{})
+
+		document.Rules = append(document.Rules, storageManagementPolicyRule{
+			Name: rule["name"].(string),
+			Type: "Lifecycle",
+			Definition: storageManagementPolicyRuleDefinition{
+				Filters: expandStorageManagementPolicyFilters(rule["filters"].([]interface{})),
+				Actions: expandStorageManagementPolicyActions(rule["actions"].([]interface{})),
+			},
+		})
+	}
+
+	// sort the rules so that the request is deterministic, since they're held in a Set
+	sort.Slice(document.Rules, func(i, j int) bool {
+		return document.Rules[i].Name < document.Rules[j].Name
+	})
+
+	// the SDK accepts the Policy as a JSON object, so round-trip the document to obtain one
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the Management Policy: %+v", err)
+	}
+
+	var policy interface{}
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return nil, fmt.Errorf("Error deserializing the Management Policy: %+v", err)
+	}
+
+	return policy, nil
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) *storageManagementPolicyFilters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	filters := storageManagementPolicyFilters{}
+	if blobTypes := v["blob_types"].(*schema.Set).List(); len(blobTypes) > 0 {
+		filters.BlobTypes = *utils.ExpandStringArray(blobTypes)
+		sort.Strings(filters.BlobTypes)
+	}
+	if prefixes := v["prefix_match"].(*schema.Set).List(); len(prefixes) > 0 {
+		filters.PrefixMatch = *utils.ExpandStringArray(prefixes)
+		sort.Strings(filters.PrefixMatch)
+	}
+
+	return &filters
+}
+
+func expandStorageManagementPolicyActions(input []interface{}) storageManagementPolicyActions {
+	actions := storageManagementPolicyActions{}
+	if len(input) == 0 || input[0] == nil {
+		return actions
+	}
+	v := input[0].(map[string]interface{})
+
+	if baseBlobs := v["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		b := baseBlobs[0].(map[string]interface{})
+		baseBlob := storageManagementPolicyBaseBlob{}
+
+		if days := b["tier_to_cool_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlob.TierToCool = &storageManagementPolicyDaysAfterModification{DaysAfterModificationGreaterThan: days}
+		}
+		if days := b["tier_to_archive_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlob.TierToArchive = &storageManagementPolicyDaysAfterModification{DaysAfterModificationGreaterThan: days}
+		}
+		if days := b["delete_after_days_since_modification_greater_than"].(int); days > 0 {
+			baseBlob.Delete = &storageManagementPolicyDaysAfterModification{DaysAfterModificationGreaterThan: days}
+		}
+
+		if baseBlob.TierToCool != nil || baseBlob.TierToArchive != nil || baseBlob.Delete != nil {
+			actions.BaseBlob = &baseBlob
+		}
+	}
+
+	if snapshots := v["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		s := snapshots[0].(map[string]interface{})
+
+		if days := s["delete_after_days_since_creation_greater_than"].(int); days > 0 {
+			actions.Snapshot = &storageManagementPolicySnapshot{
+				Delete: &storageManagementPolicyDaysAfterCreation{DaysAfterCreationGreaterThan: days},
+			}
+		}
+	}
+
+	return actions
+}
+
+// flattenStorageManagementPolicyRules parses the JSON Policy returned from the API into the typed document, so that
+// differences in the formatting or ordering of the JSON don't show up as a diff
+func flattenStorageManagementPolicyRules(input interface{}) ([]interface{}, error) {
+	rules := make([]interface{}, 0)
+	if input == nil {
+		return rules, nil
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the Management Policy: %+v", err)
+	}
+
+	var document storageManagementPolicyDocument
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("Error deserializing the Management Policy: %+v", err)
+	}
+
+	for _, rule := range document.Rules {
+		if !strings.EqualFold(rule.Type, "Lifecycle") {
+			log.Printf("[DEBUG] Skipping Management Policy Rule %q since it has the unsupported type %q", rule.Name, rule.Type)
+			continue
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"name":    rule.Name,
+			"filters": flattenStorageManagementPolicyFilters(rule.Definition.Filters),
+			"actions": flattenStorageManagementPolicyActions(rule.Definition.Actions),
+		})
+	}
+
+	return rules, nil
+}
+
+func flattenStorageManagementPolicyFilters(input *storageManagementPolicyFilters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"blob_types":   schema.NewSet(schema.HashString, utils.FlattenStringArray(&input.BlobTypes)),
+			"prefix_match": schema.NewSet(schema.HashString, utils.FlattenStringArray(&input.PrefixMatch)),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input storageManagementPolicyActions) []interface{} {
+	baseBlobs := make([]interface{}, 0)
+	if b := input.BaseBlob; b != nil {
+		baseBlob := map[string]interface{}{}
+		if b.TierToCool != nil {
+			baseBlob["tier_to_cool_after_days_since_modification_greater_than"] = b.TierToCool.DaysAfterModificationGreaterThan
+		}
+		if b.TierToArchive != nil {
+			baseBlob["tier_to_archive_after_days_since_modification_greater_than"] = b.TierToArchive.DaysAfterModificationGreaterThan
+		}
+		if b.Delete != nil {
+			baseBlob["delete_after_days_since_modification_greater_than"] = b.Delete.DaysAfterModificationGreaterThan
+		}
+		baseBlobs = append(baseBlobs, baseBlob)
+	}
+
+	snapshots := make([]interface{}, 0)
+	if s := input.Snapshot; s != nil && s.Delete != nil {
+		snapshots = append(snapshots, map[string]interface{}{
+			"delete_after_days_since_creation_greater_than": s.Delete.DaysAfterCreationGreaterThan,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_blob": baseBlobs,
+			"snapshot":  snapshots,
+		},
+	}
+}
@@ -0,0 +1,489 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestValidateStorageManagementPolicyRuleName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "rule1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ArchiveLogs",
+			ErrCount: 0,
+		},
+		{
+			Value:    "archive-logs",
+			ErrCount: 1,
+		},
+		{
+			Value:    "archive logs",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 256),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 257),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateStorageManagementPolicyRuleName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the Rule Name %q to trigger %d validation errors but got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestValidateStorageManagementPolicyRules(t *testing.T) {
+	rule := func(name string, coolAfterDays int, snapshotDeleteAfterDays int) interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"actions": []interface{}{
+				map[string]interface{}{
+					"base_blob": []interface{}{
+						map[string]interface{}{
+							"tier_to_cool_after_days_since_modification_greater_than":    coolAfterDays,
+							"tier_to_archive_after_days_since_modification_greater_than": 0,
+							"delete_after_days_since_modification_greater_than":          0,
+						},
+					},
+					"snapshot": []interface{}{
+						map[string]interface{}{
+							"delete_after_days_since_creation_greater_than": snapshotDeleteAfterDays,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Rules         []interface{}
+		ErrorContains string
+	}{
+		{
+			Name:  "Single Rule",
+			Rules: []interface{}{rule("rule1", 30, 0)},
+		},
+		{
+			Name:  "Unique Names",
+			Rules: []interface{}{rule("rule1", 30, 0), rule("rule2", 0, 90)},
+		},
+		{
+			Name:  "Names Differing in Case",
+			Rules: []interface{}{rule("rule1", 30, 0), rule("RULE1", 30, 0)},
+		},
+		{
+			Name:          "Duplicate Names",
+			Rules:         []interface{}{rule("rule1", 30, 0), rule("rule1", 0, 90)},
+			ErrorContains: "is used by more than one `rule` block",
+		},
+		{
+			Name:          "No Actions",
+			Rules:         []interface{}{rule("rule1", 0, 0)},
+			ErrorContains: "must specify at least one action",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateStorageManagementPolicyRules(v.Rules)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error containing %q but didn't get one", v.ErrorContains)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected an error containing %q but got: %+v", v.ErrorContains, err)
+		}
+	}
+}
+
+func TestFlattenStorageManagementPolicyRules(t *testing.T) {
+	// the API returns the Policy as a JSON object - which may differ in key ordering from the request
+	policy := map[string]interface{}{
+		"rules": []interface{}{
+			map[string]interface{}{
+				"type": "Lifecycle",
+				"name": "rule1",
+				"definition": map[string]interface{}{
+					"actions": map[string]interface{}{
+						"snapshot": map[string]interface{}{
+							"delete": map[string]interface{}{
+								"daysAfterCreationGreaterThan": float64(30),
+							},
+						},
+						"baseBlob": map[string]interface{}{
+							"tierToCool": map[string]interface{}{
+								"daysAfterModificationGreaterThan": float64(10),
+							},
+							"delete": map[string]interface{}{
+								"daysAfterModificationGreaterThan": float64(100),
+							},
+						},
+					},
+					"filters": map[string]interface{}{
+						"prefixMatch": []interface{}{"container2/prefix1", "container1/prefix1"},
+						"blobTypes":   []interface{}{"blockBlob"},
+					},
+				},
+			},
+		},
+		"version": "0.5",
+	}
+
+	rules, err := flattenStorageManagementPolicyRules(policy)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule but got %d", len(rules))
+	}
+
+	rule := rules[0].(map[string]interface{})
+	if rule["name"].(string) != "rule1" {
+		t.Fatalf("Expected the rule name to be `rule1` but got %q", rule["name"])
+	}
+
+	filters := rule["filters"].([]interface{})[0].(map[string]interface{})
+	if prefixes := filters["prefix_match"].(*schema.Set); prefixes.Len() != 2 || !prefixes.Contains("container1/prefix1") {
+		t.Fatalf("Expected `prefix_match` to contain 2 prefixes but got %+v", prefixes.List())
+	}
+
+	actions := rule["actions"].([]interface{})[0].(map[string]interface{})
+	baseBlob := actions["base_blob"].([]interface{})[0].(map[string]interface{})
+	if v := baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int); v != 10 {
+		t.Fatalf("Expected `tier_to_cool_after_days_since_modification_greater_than` to be 10 but got %d", v)
+	}
+	if v := baseBlob["delete_after_days_since_modification_greater_than"].(int); v != 100 {
+		t.Fatalf("Expected `delete_after_days_since_modification_greater_than` to be 100 but got %d", v)
+	}
+	if _, ok := baseBlob["tier_to_archive_after_days_since_modification_greater_than"]; ok {
+		t.Fatalf("Expected `tier_to_archive_after_days_since_modification_greater_than` to be unset")
+	}
+
+	snapshot := actions["snapshot"].([]interface{})[0].(map[string]interface{})
+	if v := snapshot["delete_after_days_since_creation_greater_than"].(int); v != 30 {
+		t.Fatalf("Expected `delete_after_days_since_creation_greater_than` to be 30 but got %d", v)
+	}
+}
+
+func TestAccAzureRMStorageManagementPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageManagementPolicy_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_management_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_update(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_multipleRules(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageManagementPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		accountName := id.Path["storageAccounts"]
+
+		client := testAccProvider.Meta().(*ArmClient).storagePolicyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, accountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Management Policy for Storage Account %q (Resource Group %q) does not exist", accountName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storagePolicyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageManagementPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storagePolicyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_management_policy" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		accountName := id.Path["storageAccounts"]
+
+		resp, err := client.Get(ctx, resourceGroup, accountName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Management Policy for Storage Account %q (Resource Group %q) still exists", accountName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageManagementPolicy_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageManagementPolicy_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name = "rule1"
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "import" {
+  storage_account_id = "${azurerm_storage_management_policy.test.storage_account_id}"
+
+  rule {
+    name = "rule1"
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_multipleRules(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name = "rule2"
+
+    filters {
+      prefix_match = ["container2/prefix1", "container2/prefix2"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than = 11
+        delete_after_days_since_modification_greater_than       = 101
+      }
+    }
+  }
+
+  rule {
+    name = "rule1"
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
@@ -16,6 +16,7 @@ func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Delete: resourceArmStorageQueueDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -36,6 +37,10 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"metadata": storageMetaDataSchema(),
+
+			"acl": storageACLSchema("raup"),
 		},
 	}
 }
@@ -100,12 +105,19 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[INFO] Creating queue %q in storage account %q", name, storageAccountName)
+	queueReference.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
 	options := &storage.QueueServiceOptions{}
 	err = queueReference.Create(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage queue on Azure: %s", err)
 	}
 
+	if v, ok := d.GetOk("acl"); ok {
+		if err := resourceArmStorageQueueSetACL(queueReference, v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageQueueRead(d, meta)
 }
@@ -157,6 +169,76 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", *resourceGroup)
 
+	if err := queueReference.GetMetadata(&storage.QueueServiceOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving metadata for storage queue %q: %s", id.queueName, err)
+	}
+	if err := d.Set("metadata", flattenStorageMetaData(queueReference.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
+	permissions, err := queueReference.GetPermissions(&storage.GetQueuePermissionOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for storage queue %q: %s", id.queueName, err)
+	}
+	if err := d.Set("acl", flattenStorageQueueACLs(permissions.AccessPolicies)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	queueReference := queueClient.GetQueueReference(id.queueName)
+
+	if d.HasChange("metadata") {
+		log.Printf("[INFO] Setting metadata for storage queue %q", id.queueName)
+		queueReference.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
+		if err := queueReference.SetMetadata(&storage.QueueServiceOptions{}); err != nil {
+			return fmt.Errorf("Error setting metadata for storage queue %q: %s", id.queueName, err)
+		}
+	}
+
+	if d.HasChange("acl") {
+		if err := resourceArmStorageQueueSetACL(queueReference, d.Get("acl").(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
+func resourceArmStorageQueueSetACL(queueReference *storage.Queue, input []interface{}) error {
+	accessPolicies, err := expandStorageQueueACLs(input)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting ACL for storage queue %q", queueReference.Name)
+	permissions := storage.QueuePermissions{
+		AccessPolicies: accessPolicies,
+	}
+	if err := queueReference.SetPermissions(permissions, &storage.SetQueuePermissionOptions{}); err != nil {
+		return fmt.Errorf("Error setting ACL for storage queue %q: %s", queueReference.Name, err)
+	}
+
 	return nil
 }
 
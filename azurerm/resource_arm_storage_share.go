@@ -44,6 +44,20 @@ func resourceArmStorageShare() *schema.Resource {
 				Default:      5120,
 				ValidateFunc: validation.IntBetween(1, 5120),
 			},
+			"access_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Cool",
+					"Hot",
+					"TransactionOptimized",
+				}, false),
+			},
+			"metadata": storageMetaDataSchema(),
+
+			"acl": storageACLSchema("rwdl"),
+
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -67,7 +81,7 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	name := d.Get("name").(string)
-	metaData := make(map[string]string) // TODO: support MetaData
+	metaData := expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
 	options := &storage.FileRequestOptions{}
 
 	log.Printf("[INFO] Creating share %q in storage account %q", name, storageAccountName)
@@ -104,6 +118,18 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
 	}
 
+	if v, ok := d.GetOk("access_tier"); ok {
+		if err := resourceArmStorageShareSetAccessTier(armClient, resourceGroupName, storageAccountName, name, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		if err := resourceArmStorageShareSetACL(armClient, resourceGroupName, storageAccountName, name, v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageShareRead(d, meta)
 }
@@ -156,6 +182,45 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("quota", reference.Properties.Quota)
 
+	if err := d.Set("metadata", flattenStorageMetaData(reference.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
+	tierClient, accountExists, err := armClient.getFileShareAccessTierClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing file %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tier, err := tierClient.GetAccessTier(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Access Tier for Storage Share %q: %+v", name, err)
+	}
+	d.Set("access_tier", tier.AccessTier)
+
+	aclClient, accountExists, err := armClient.getFileShareACLClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing file %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := aclClient.GetACL(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for Storage Share %q: %+v", name, err)
+	}
+
+	if err := d.Set("acl", flattenStorageACLs(acl.SignedIdentifiers)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -183,17 +248,82 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 
 	reference := fileClient.GetShareReference(name)
 
-	log.Printf("[INFO] Setting share %q properties in storage account %q", name, storageAccountName)
-	reference.Properties = storage.ShareProperties{
-		Quota: d.Get("quota").(int),
+	if d.HasChange("quota") {
+		log.Printf("[INFO] Setting share %q properties in storage account %q", name, storageAccountName)
+		reference.Properties = storage.ShareProperties{
+			Quota: d.Get("quota").(int),
+		}
+		if err := reference.SetProperties(options); err != nil {
+			return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
+		}
 	}
-	if err := reference.SetProperties(options); err != nil {
-		return fmt.Errorf("Error setting properties on Storage Share %q: %+v", name, err)
+
+	if d.HasChange("metadata") {
+		log.Printf("[INFO] Setting share %q metadata in storage account %q", name, storageAccountName)
+		reference.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
+		if err := reference.SetMetadata(options); err != nil {
+			return fmt.Errorf("Error setting metadata on Storage Share %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("access_tier") {
+		if err := resourceArmStorageShareSetAccessTier(armClient, resourceGroupName, storageAccountName, name, d.Get("access_tier").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("acl") {
+		if err := resourceArmStorageShareSetACL(armClient, resourceGroupName, storageAccountName, name, d.Get("acl").(*schema.Set).List()); err != nil {
+			return err
+		}
 	}
 
 	return resourceArmStorageShareRead(d, meta)
 }
 
+func resourceArmStorageShareSetACL(armClient *ArmClient, resourceGroupName, storageAccountName, name string, input []interface{}) error {
+	ctx := armClient.StopContext
+
+	aclClient, accountExists, err := armClient.getFileShareACLClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	acls, err := expandStorageACLs(input)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting share %q ACL in storage account %q", name, storageAccountName)
+	if _, err := aclClient.SetACL(ctx, name, acls); err != nil {
+		return fmt.Errorf("Error setting ACL on Storage Share %q: %+v", name, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageShareSetAccessTier(armClient *ArmClient, resourceGroupName, storageAccountName, name, accessTier string) error {
+	ctx := armClient.StopContext
+
+	tierClient, accountExists, err := armClient.getFileShareAccessTierClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	log.Printf("[INFO] Setting share %q access tier in storage account %q", name, storageAccountName)
+	if _, err := tierClient.SetAccessTier(ctx, name, accessTier); err != nil {
+		return fmt.Errorf("Error setting Access Tier on Storage Share %q: %+v", name, err)
+	}
+
+	return nil
+}
+
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func resourceArmStorageShareDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageShareDirectoryCreate,
+		Read:   resourceArmStorageShareDirectoryRead,
+		Update: resourceArmStorageShareDirectoryUpdate,
+		Delete: resourceArmStorageShareDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareDirectoryName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageShareName,
+			},
+
+			"metadata": storageMetaDataSchema(),
+		},
+	}
+}
+
+func resourceArmStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	name := d.Get("name").(string)
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+	shareName := d.Get("share_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	reference := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+
+	id := fmt.Sprintf("https://%s.file.%s/%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, shareName, name)
+	if requireResourcesToBeImported {
+		exists, e := reference.Exists()
+		if e != nil {
+			return fmt.Errorf("Error checking if Directory %q exists (Share %q / Account %q / Resource Group %q): %s", name, shareName, storageAccountName, resourceGroupName, e)
+		}
+
+		if exists {
+			return tf.ImportAsExistsError("azurerm_storage_share_directory", id)
+		}
+	}
+
+	log.Printf("[INFO] Creating directory %q in share %q (storage account %q)", name, shareName, storageAccountName)
+	reference.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
+	options := &storage.FileRequestOptions{}
+	if err := reference.Create(options); err != nil {
+		return fmt.Errorf("Error creating Directory %q (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+	}
+
+	d.SetId(id)
+	return resourceArmStorageShareDirectoryRead(d, meta)
+}
+
+func resourceArmStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageShareDirectoryID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	reference := fileClient.GetShareReference(id.shareName).GetRootDirectoryReference().GetDirectoryReference(id.directoryName)
+
+	if d.HasChange("metadata") {
+		log.Printf("[INFO] Setting metadata for directory %q in share %q (storage account %q)", id.directoryName, id.shareName, id.storageAccountName)
+		reference.Metadata = expandStorageMetaData(d.Get("metadata").(map[string]interface{}))
+		options := &storage.FileRequestOptions{}
+		if err := reference.SetMetadata(options); err != nil {
+			return fmt.Errorf("Error setting metadata on Directory %q (Share %q / Storage Account %q): %+v", id.directoryName, id.shareName, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageShareDirectoryRead(d, meta)
+}
+
+func resourceArmStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageShareDirectoryID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q (assuming removed) - removing from state", id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing directory %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	reference := fileClient.GetShareReference(id.shareName).GetRootDirectoryReference().GetDirectoryReference(id.directoryName)
+	exists, err := reference.Exists()
+	if err != nil {
+		return fmt.Errorf("Error checking if Directory %q exists (Share %q / Storage Account %q): %+v", id.directoryName, id.shareName, id.storageAccountName, err)
+	}
+	if !exists {
+		log.Printf("[INFO] Directory %q no longer exists in share %q, removing from state...", id.directoryName, id.shareName)
+		d.SetId("")
+		return nil
+	}
+
+	if err := reference.FetchAttributes(nil); err != nil {
+		return fmt.Errorf("Error retrieving properties of Directory %q (Share %q / Storage Account %q): %+v", id.directoryName, id.shareName, id.storageAccountName, err)
+	}
+
+	d.Set("name", id.directoryName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("share_name", id.shareName)
+
+	if err := d.Set("metadata", flattenStorageMetaData(reference.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageShareDirectoryID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the directory won't exist", id.storageAccountName)
+		return nil
+	}
+
+	// the Storage service only allows empty directories to be deleted
+	log.Printf("[INFO] Deleting directory %q from share %q (storage account %q)", id.directoryName, id.shareName, id.storageAccountName)
+	reference := fileClient.GetShareReference(id.shareName).GetRootDirectoryReference().GetDirectoryReference(id.directoryName)
+	options := &storage.FileRequestOptions{}
+	if _, err := reference.DeleteIfExists(options); err != nil {
+		return fmt.Errorf("Error deleting Directory %q (Share %q / Storage Account %q): %+v", id.directoryName, id.shareName, id.storageAccountName, err)
+	}
+
+	return nil
+}
+
+// validateArmStorageShareDirectoryName validates the path of a directory, where each of the parent directories
+// must exist - see https://docs.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-shares--directories--files--and-metadata
+func validateArmStorageShareDirectoryName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot begin or end with a `/`: %q", k, value))
+	}
+
+	if len(value) > 2048 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 2048 characters: %q", k, value))
+	}
+
+	for _, segment := range strings.Split(strings.Trim(value, "/"), "/") {
+		if len(segment) < 1 || len(segment) > 255 {
+			errors = append(errors, fmt.Errorf("each directory in %q must be between 1 and 255 characters: %q", k, value))
+			break
+		}
+
+		if regexp.MustCompile(`["\\:|<>*?]`).MatchString(segment) {
+			errors = append(errors, fmt.Errorf("%q cannot contain the characters `\"\\:|<>*?`: %q", k, value))
+			break
+		}
+	}
+
+	return warnings, errors
+}
+
+type storageShareDirectoryId struct {
+	storageAccountName string
+	shareName          string
+	directoryName      string
+}
+
+func parseStorageShareDirectoryID(input string, environment azure.Environment) (*storageShareDirectoryId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as URI: %+v", input, err)
+	}
+
+	// trim the leading `/`
+	segments := strings.Split(strings.TrimPrefix(uri.Path, "/"), "/")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("Expected the path to contain at least 2 segments but got %d", len(segments))
+	}
+
+	storageAccountName := strings.Replace(uri.Host, fmt.Sprintf(".file.%s", environment.StorageEndpointSuffix), "", 1)
+	shareName := segments[0]
+	directoryName := strings.TrimPrefix(uri.Path, fmt.Sprintf("/%s/", shareName))
+
+	id := storageShareDirectoryId{
+		storageAccountName: storageAccountName,
+		shareName:          shareName,
+		directoryName:      directoryName,
+	}
+	return &id, nil
+}
@@ -0,0 +1,380 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMStorageShareDirectory_basic(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageShareDirectory_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_share_directory"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_metaData(t *testing.T) {
+	resourceName := "azurerm_storage_share_directory.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_metaData(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageShareDirectory_metaDataUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageShareDirectory_nested(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShareDirectory_nested(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.parent"),
+					testCheckAzureRMStorageShareDirectoryExists("azurerm_storage_share_directory.child"),
+					resource.TestCheckResourceAttr("azurerm_storage_share_directory.child", "name", "parent/child"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageShareDirectoryExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		reference := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		exists, err := reference.Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking if Directory %q exists (Share %q / Storage Account %q): %+v", name, shareName, storageAccountName, err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q / Storage Account %q) does not exist", name, shareName, storageAccountName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageShareDirectoryDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_share_directory" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		shareName := rs.Primary.Attributes["share_name"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			// the Storage Account has been deleted along with the Resource Group
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		reference := fileClient.GetShareReference(shareName).GetRootDirectoryReference().GetDirectoryReference(name)
+		exists, err := reference.Exists()
+		if err != nil {
+			return nil
+		}
+
+		if exists {
+			return fmt.Errorf("Bad: Directory %q (Share %q / Storage Account %q) still exists", name, shareName, storageAccountName)
+		}
+	}
+
+	return nil
+}
+
+func TestValidateArmStorageShareDirectoryName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "hello", ErrCount: 0},
+		{Value: "hello/world", ErrCount: 0},
+		{Value: "Hello World-123", ErrCount: 0},
+		{Value: "/hello", ErrCount: 1},
+		{Value: "hello/", ErrCount: 1},
+		{Value: "hello//world", ErrCount: 1},
+		{Value: "hello:world", ErrCount: 1},
+		{Value: "hello/wor*ld", ErrCount: 1},
+		{Value: strings.Repeat("a", 255), ErrCount: 0},
+		{Value: strings.Repeat("a", 256), ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageShareDirectoryName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for Directory Name %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestParseStorageShareDirectoryID(t *testing.T) {
+	cases := []struct {
+		Input     string
+		Directory string
+		Error     bool
+	}{
+		{
+			Input: "https://account1.file.core.windows.net/share1",
+			Error: true,
+		},
+		{
+			Input:     "https://account1.file.core.windows.net/share1/directory1",
+			Directory: "directory1",
+		},
+		{
+			Input:     "https://account1.file.core.windows.net/share1/parent/child",
+			Directory: "parent/child",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		id, err := parseStorageShareDirectoryID(tc.Input, azure.PublicCloud)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if id.storageAccountName != "account1" {
+			t.Fatalf("Expected the Storage Account Name to be %q but got %q", "account1", id.storageAccountName)
+		}
+		if id.shareName != "share1" {
+			t.Fatalf("Expected the Share Name to be %q but got %q", "share1", id.shareName)
+		}
+		if id.directoryName != tc.Directory {
+			t.Fatalf("Expected the Directory Name to be %q but got %q", tc.Directory, id.directoryName)
+		}
+	}
+}
+
+func testAccAzureRMStorageShareDirectory_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  quota                = 50
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageShareDirectory_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "import" {
+  name                 = "${azurerm_storage_share_directory.test.name}"
+  resource_group_name  = "${azurerm_storage_share_directory.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_share_directory.test.storage_account_name}"
+  share_name           = "${azurerm_storage_share_directory.test.share_name}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_metaData(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_metaDataUpdated(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "test" {
+  name                 = "dir"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+
+  metadata = {
+    hello    = "world"
+    sunshine = "at_night"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageShareDirectory_nested(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageShareDirectory_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory" "parent" {
+  name                 = "parent"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+
+resource "azurerm_storage_share_directory" "child" {
+  name                 = "${azurerm_storage_share_directory.parent.name}/child"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  share_name           = "${azurerm_storage_share.test.name}"
+}
+`, template)
+}
@@ -16,6 +16,7 @@ func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageTableCreate,
 		Read:   resourceArmStorageTableRead,
+		Update: resourceArmStorageTableUpdate,
 		Delete: resourceArmStorageTableDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -36,6 +37,8 @@ func resourceArmStorageTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"acl": storageACLSchema("raud"),
 		},
 	}
 }
@@ -99,6 +102,12 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating table %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
+	if v, ok := d.GetOk("acl"); ok {
+		if err := resourceArmStorageTableSetACL(table, v.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageTableRead(d, meta)
 }
@@ -159,6 +168,59 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", resourceGroup)
 
+	table := tableClient.GetTableReference(id.tableName)
+	accessPolicies, err := table.GetPermissions(60, &storage.TableOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL for table %q in Storage Account %q: %s", id.tableName, id.storageAccountName, err)
+	}
+	if err := d.Set("acl", flattenStorageTableACLs(accessPolicies)); err != nil {
+		return fmt.Errorf("Error setting `acl`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		table := tableClient.GetTableReference(id.tableName)
+		if err := resourceArmStorageTableSetACL(table, d.Get("acl").(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageTableRead(d, meta)
+}
+
+func resourceArmStorageTableSetACL(table *storage.Table, input []interface{}) error {
+	accessPolicies, err := expandStorageTableACLs(input)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting ACL for table %q", table.Name)
+	timeout := uint(60)
+	if err := table.SetPermissions(accessPolicies, timeout, &storage.TableOptions{}); err != nil {
+		return fmt.Errorf("Error setting ACL for table %q: %s", table.Name, err)
+	}
+
 	return nil
 }
 
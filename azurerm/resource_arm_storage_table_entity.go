@@ -0,0 +1,433 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func resourceArmStorageTableEntity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageTableEntityCreateUpdate,
+		Read:   resourceArmStorageTableEntityRead,
+		Update: resourceArmStorageTableEntityCreateUpdate,
+		Delete: resourceArmStorageTableEntityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableName,
+			},
+
+			"partition_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+
+			"row_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageTableEntityKey,
+			},
+
+			"property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArmStorageTableEntityPropertyName,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "String",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Binary",
+								"Boolean",
+								"DateTime",
+								"Double",
+								"Guid",
+								"Int32",
+								"Int64",
+								"String",
+							}, false),
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	table := tableClient.GetTableReference(tableName)
+	entity := table.GetEntityReference(partitionKey, rowKey)
+
+	id := fmt.Sprintf("https://%s.table.%s/%s(PartitionKey='%s',RowKey='%s')", storageAccountName, armClient.environment.StorageEndpointSuffix, tableName, partitionKey, rowKey)
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing := table.GetEntityReference(partitionKey, rowKey)
+		err := existing.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{})
+		if err == nil {
+			return tf.ImportAsExistsError("azurerm_storage_table_entity", id)
+		}
+		if !storageTableEntityWasNotFound(err) {
+			return fmt.Errorf("Error checking if Entity (Partition Key %q / Row Key %q) exists in Table %q (Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, resourceGroupName, err)
+		}
+	}
+
+	properties, err := expandStorageTableEntityProperties(d.Get("property").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	entity.Properties = properties
+
+	// Insert Or Replace removes any properties which aren't specified, which is what we want for both a Create & Update
+	log.Printf("[INFO] Creating/Updating Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q)", partitionKey, rowKey, tableName, storageAccountName)
+	if err := entity.InsertOrReplace(&storage.EntityOptions{}); err != nil {
+		return fmt.Errorf("Error creating/updating Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q / Resource Group %q): %s", partitionKey, rowKey, tableName, storageAccountName, resourceGroupName, err)
+	}
+
+	d.SetId(id)
+	return resourceArmStorageTableEntityRead(d, meta)
+}
+
+func resourceArmStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to determine Resource Group for Storage Account %q (assuming removed) - removing from state", id.storageAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing entity %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	table := tableClient.GetTableReference(id.tableName)
+	entity := table.GetEntityReference(id.partitionKey, id.rowKey)
+	if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) was not found in Table %q - removing from state", id.partitionKey, id.rowKey, id.tableName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q / Resource Group %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, *resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("storage_account_name", id.storageAccountName)
+	d.Set("table_name", id.tableName)
+	d.Set("partition_key", id.partitionKey)
+	d.Set("row_key", id.rowKey)
+
+	properties, err := flattenStorageTableEntityProperties(entity.Properties, d.Get("property").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("property", properties); err != nil {
+		return fmt.Errorf("Error setting `property`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableEntityID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[INFO] Storage Account %q doesn't exist so the entity won't exist", id.storageAccountName)
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q)", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName)
+	table := tableClient.GetTableReference(id.tableName)
+	entity := table.GetEntityReference(id.partitionKey, id.rowKey)
+	if err := entity.Delete(true, &storage.EntityOptions{}); err != nil {
+		if storageTableEntityWasNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Entity (Partition Key %q / Row Key %q) from Table %q (Storage Account %q / Resource Group %q): %s", id.partitionKey, id.rowKey, id.tableName, id.storageAccountName, resourceGroupName, err)
+	}
+
+	return nil
+}
+
+func storageTableEntityWasNotFound(err error) bool {
+	if storageErr, ok := err.(storage.AzureStorageServiceError); ok {
+		return storageErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// the Partition and Row Keys form part of the ID, so in addition to the characters the service disallows
+// (see https://docs.microsoft.com/en-us/rest/api/storageservices/understanding-the-table-service-data-model)
+// single quotes aren't supported, since the Storage SDK doesn't escape them
+func validateArmStorageTableEntityKey(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1024 characters: %q", k, value))
+	}
+
+	if regexp.MustCompile(`[/\\#?'\x00-\x1F\x7F-\x9F]`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain the characters `/`, `\\`, `#`, `?`, `'` or control characters: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func validateArmStorageTableEntityPropertyName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,254}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter or underscore, only contain alphanumeric characters and underscores and be at most 255 characters: %q", k, value))
+	}
+
+	for _, reserved := range []string{"PartitionKey", "RowKey", "Timestamp"} {
+		if value == reserved {
+			errors = append(errors, fmt.Errorf("%q cannot be the reserved property %q", k, value))
+		}
+	}
+
+	return warnings, errors
+}
+
+// storageTableEntityDouble ensures whole numbers are serialized with a decimal point, since otherwise the Table
+// service infers them as an Int32 (and the Storage SDK doesn't allow an `Edm.Double` type annotation)
+type storageTableEntityDouble float64
+
+func (d storageTableEntityDouble) MarshalJSON() ([]byte, error) {
+	value := float64(d)
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil, fmt.Errorf("%v isn't a supported Double value", value)
+	}
+
+	output := strconv.FormatFloat(value, 'f', -1, 64)
+	if !strings.Contains(output, ".") {
+		output += ".0"
+	}
+	return []byte(output), nil
+}
+
+func expandStorageTableEntityProperties(input []interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	for _, v := range input {
+		property := v.(map[string]interface{})
+		name := property["name"].(string)
+		propertyType := property["type"].(string)
+		value := property["value"].(string)
+
+		if _, exists := output[name]; exists {
+			return nil, fmt.Errorf("The property %q is defined more than once", name)
+		}
+
+		var err error
+		switch propertyType {
+		case "Binary":
+			output[name], err = base64.StdEncoding.DecodeString(value)
+		case "Boolean":
+			output[name], err = strconv.ParseBool(value)
+		case "DateTime":
+			var t time.Time
+			t, err = time.Parse(time.RFC3339, value)
+			output[name] = t.UTC()
+		case "Double":
+			var f float64
+			f, err = strconv.ParseFloat(value, 64)
+			output[name] = storageTableEntityDouble(f)
+		case "Guid":
+			output[name], err = uuid.FromString(value)
+		case "Int32":
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 32)
+			output[name] = int32(i)
+		case "Int64":
+			output[name], err = strconv.ParseInt(value, 10, 64)
+		default:
+			output[name] = value
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the value of the property %q as a %s: %+v", name, propertyType, err)
+		}
+	}
+
+	return output, nil
+}
+
+// flattenStorageTableEntityProperties converts the properties returned from the Table service, which only includes the
+// type for properties which can't be inferred from the JSON - as such the configured type is used for numbers
+func flattenStorageTableEntityProperties(input map[string]interface{}, configured []interface{}) ([]interface{}, error) {
+	configuredTypes := make(map[string]string)
+	for _, v := range configured {
+		property := v.(map[string]interface{})
+		configuredTypes[property["name"].(string)] = property["type"].(string)
+	}
+
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := make([]interface{}, 0)
+	for _, name := range names {
+		var propertyType, value string
+
+		switch v := input[name].(type) {
+		case []byte:
+			propertyType = "Binary"
+			value = base64.StdEncoding.EncodeToString(v)
+		case bool:
+			propertyType = "Boolean"
+			value = strconv.FormatBool(v)
+		case time.Time:
+			propertyType = "DateTime"
+			value = v.UTC().Format(time.RFC3339)
+		case float64:
+			if configuredTypes[name] == "Double" || v != math.Trunc(v) {
+				propertyType = "Double"
+				value = strconv.FormatFloat(v, 'f', -1, 64)
+			} else {
+				propertyType = "Int32"
+				value = strconv.FormatInt(int64(v), 10)
+			}
+		case uuid.UUID:
+			propertyType = "Guid"
+			value = v.String()
+		case int64:
+			propertyType = "Int64"
+			value = strconv.FormatInt(v, 10)
+		case string:
+			propertyType = "String"
+			value = v
+		default:
+			return nil, fmt.Errorf("The property %q has an unsupported type %T", name, v)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"type":  propertyType,
+			"value": value,
+		})
+	}
+
+	return output, nil
+}
+
+type storageTableEntityId struct {
+	storageAccountName string
+	tableName          string
+	partitionKey       string
+	rowKey             string
+}
+
+func parseStorageTableEntityID(input string, environment azure.Environment) (*storageTableEntityId, error) {
+	// https://myaccount.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a URI: %+v", input, err)
+	}
+
+	matches := regexp.MustCompile(`^/([A-Za-z][A-Za-z0-9]{2,62})\(PartitionKey='([^']*)',RowKey='([^']*)'\)$`).FindStringSubmatch(uri.Path)
+	if len(matches) != 4 {
+		return nil, fmt.Errorf("Expected the path to be in the format `/{tableName}(PartitionKey='{partitionKey}',RowKey='{rowKey}')` but got %q", uri.Path)
+	}
+
+	id := storageTableEntityId{
+		storageAccountName: strings.Replace(uri.Host, fmt.Sprintf(".table.%s", environment.StorageEndpointSuffix), "", 1),
+		tableName:          matches[1],
+		partitionKey:       matches[2],
+		rowKey:             matches[3],
+	}
+	return &id, nil
+}
@@ -0,0 +1,527 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMStorageTableEntity_basic(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageTableEntity_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_table_entity"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageTableEntity_update(t *testing.T) {
+	resourceName := "azurerm_storage_table_entity.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageTableEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageTableEntity_typed(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageTableEntity_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageTableEntityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageTableEntityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", storageAccountName)
+		}
+
+		entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err != nil {
+			if storageTableEntityWasNotFound(err) {
+				return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) does not exist in Table %q", partitionKey, rowKey, tableName)
+			}
+
+			return fmt.Errorf("Error retrieving Entity (Partition Key %q / Row Key %q) from Table %q: %+v", partitionKey, rowKey, tableName, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageTableEntityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_table_entity" {
+			continue
+		}
+
+		tableName := rs.Primary.Attributes["table_name"]
+		partitionKey := rs.Primary.Attributes["partition_key"]
+		rowKey := rs.Primary.Attributes["row_key"]
+		storageAccountName := rs.Primary.Attributes["storage_account_name"]
+		resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+
+		armClient := testAccProvider.Meta().(*ArmClient)
+		ctx := armClient.StopContext
+		tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			// the Storage Account has been deleted along with the Resource Group
+			return nil
+		}
+		if !accountExists {
+			return nil
+		}
+
+		entity := tableClient.GetTableReference(tableName).GetEntityReference(partitionKey, rowKey)
+		if err := entity.Get(60, storage.MinimalMetadata, &storage.GetEntityOptions{}); err == nil {
+			return fmt.Errorf("Bad: Entity (Partition Key %q / Row Key %q) still exists in Table %q", partitionKey, rowKey, tableName)
+		}
+	}
+
+	return nil
+}
+
+func TestValidateArmStorageTableEntityKey(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "", ErrCount: 0},
+		{Value: "partition1", ErrCount: 0},
+		{Value: "Hello World-123", ErrCount: 0},
+		{Value: "hello/world", ErrCount: 1},
+		{Value: "hello\\world", ErrCount: 1},
+		{Value: "hello#world", ErrCount: 1},
+		{Value: "hello?world", ErrCount: 1},
+		{Value: "o'neill", ErrCount: 1},
+		{Value: "hello\tworld", ErrCount: 1},
+		{Value: strings.Repeat("a", 1024), ErrCount: 0},
+		{Value: strings.Repeat("a", 1025), ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageTableEntityKey(tc.Value, "partition_key")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for Key %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateArmStorageTableEntityPropertyName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{Value: "Name", ErrCount: 0},
+		{Value: "_private", ErrCount: 0},
+		{Value: "value_2", ErrCount: 0},
+		{Value: "2value", ErrCount: 1},
+		{Value: "hello-world", ErrCount: 1},
+		{Value: "PartitionKey", ErrCount: 1},
+		{Value: "RowKey", ErrCount: 1},
+		{Value: "Timestamp", ErrCount: 1},
+		{Value: strings.Repeat("a", 255), ErrCount: 0},
+		{Value: strings.Repeat("a", 256), ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageTableEntityPropertyName(tc.Value, "name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for Property Name %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestStorageTableEntityPropertiesRoundTrip(t *testing.T) {
+	property := func(name, propertyType, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":  name,
+			"type":  propertyType,
+			"value": value,
+		}
+	}
+
+	input := []interface{}{
+		property("Binary", "Binary", "SGVsbG8gV29ybGQ="),
+		property("Boolean", "Boolean", "true"),
+		property("DateTime", "DateTime", "2019-07-02T09:38:21Z"),
+		property("Double", "Double", "2"),
+		property("Fraction", "Double", "1.5"),
+		property("Guid", "Guid", "c6a06e79-8e36-4a52-8c66-bc3d7d5d2b1e"),
+		property("Int32", "Int32", "42"),
+		property("Int64", "Int64", "9223372036854775807"),
+		property("String", "String", "Hello World"),
+	}
+
+	expanded, err := expandStorageTableEntityProperties(input)
+	if err != nil {
+		t.Fatalf("Error expanding properties: %+v", err)
+	}
+
+	// round-trip the properties through the Storage SDK's serialization, as the Table service would
+	entity := storage.Entity{
+		PartitionKey: "partition1",
+		RowKey:       "row1",
+		Properties:   expanded,
+	}
+	serialized, err := json.Marshal(&entity)
+	if err != nil {
+		t.Fatalf("Error serializing entity: %+v", err)
+	}
+
+	var deserialized storage.Entity
+	if err := json.Unmarshal(serialized, &deserialized); err != nil {
+		t.Fatalf("Error deserializing entity: %+v", err)
+	}
+
+	actual, err := flattenStorageTableEntityProperties(deserialized.Properties, input)
+	if err != nil {
+		t.Fatalf("Error flattening properties: %+v", err)
+	}
+
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestExpandStorageTableEntityPropertiesInvalid(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input []interface{}
+	}{
+		{
+			Name: "Invalid Boolean",
+			Input: []interface{}{
+				map[string]interface{}{"name": "Value", "type": "Boolean", "value": "yes"},
+			},
+		},
+		{
+			Name: "Int32 Overflow",
+			Input: []interface{}{
+				map[string]interface{}{"name": "Value", "type": "Int32", "value": "2147483648"},
+			},
+		},
+		{
+			Name: "Invalid DateTime",
+			Input: []interface{}{
+				map[string]interface{}{"name": "Value", "type": "DateTime", "value": "2019-07-02"},
+			},
+		},
+		{
+			Name: "Duplicate Name",
+			Input: []interface{}{
+				map[string]interface{}{"name": "Value", "type": "String", "value": "a"},
+				map[string]interface{}{"name": "Value", "type": "Int32", "value": "1"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		if _, err := expandStorageTableEntityProperties(tc.Input); err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestStorageTableEntityDoubleMarshal(t *testing.T) {
+	cases := []struct {
+		Input    float64
+		Expected string
+	}{
+		{Input: 2, Expected: "2.0"},
+		{Input: -2, Expected: "-2.0"},
+		{Input: 1.5, Expected: "1.5"},
+		{Input: 0.000001, Expected: "0.000001"},
+	}
+
+	for _, tc := range cases {
+		actual, err := json.Marshal(storageTableEntityDouble(tc.Input))
+		if err != nil {
+			t.Fatalf("Error marshalling %v: %+v", tc.Input, err)
+		}
+
+		if string(actual) != tc.Expected {
+			t.Fatalf("Expected %v to be marshalled as %q but got %q", tc.Input, tc.Expected, string(actual))
+		}
+	}
+}
+
+func TestParseStorageTableEntityID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *storageTableEntityId
+	}{
+		{
+			Input: "https://account1.table.core.windows.net/table1",
+		},
+		{
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1')",
+		},
+		{
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Expected: &storageTableEntityId{
+				storageAccountName: "account1",
+				tableName:          "table1",
+				partitionKey:       "partition1",
+				rowKey:             "row1",
+			},
+		},
+		{
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='',RowKey='Hello World')",
+			Expected: &storageTableEntityId{
+				storageAccountName: "account1",
+				tableName:          "table1",
+				partitionKey:       "",
+				rowKey:             "Hello World",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := parseStorageTableEntityID(tc.Input, azure.PublicCloud)
+		if err != nil {
+			if tc.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if tc.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(*actual, *tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+	}
+}
+
+func testAccAzureRMStorageTableEntity_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+  partition_key        = "test_partition%d"
+  row_key              = "test_row%d"
+
+  property {
+    name  = "Foo"
+    value = "Bar"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStorageTableEntity_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "import" {
+  resource_group_name  = "${azurerm_storage_table_entity.test.resource_group_name}"
+  storage_account_name = "${azurerm_storage_table_entity.test.storage_account_name}"
+  table_name           = "${azurerm_storage_table_entity.test.table_name}"
+  partition_key        = "${azurerm_storage_table_entity.test.partition_key}"
+  row_key              = "${azurerm_storage_table_entity.test.row_key}"
+
+  property {
+    name  = "Foo"
+    value = "Bar"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageTableEntity_typed(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageTableEntity_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entity" "test" {
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+  table_name           = "${azurerm_storage_table.test.name}"
+  partition_key        = "test_partition%d"
+  row_key              = "test_row%d"
+
+  property {
+    name  = "Foo"
+    value = "Baz"
+  }
+
+  property {
+    name  = "Binary"
+    type  = "Binary"
+    value = "SGVsbG8gV29ybGQ="
+  }
+
+  property {
+    name  = "Enabled"
+    type  = "Boolean"
+    value = "true"
+  }
+
+  property {
+    name  = "Created"
+    type  = "DateTime"
+    value = "2019-07-02T09:38:21Z"
+  }
+
+  property {
+    name  = "Ratio"
+    type  = "Double"
+    value = "2"
+  }
+
+  property {
+    name  = "Identifier"
+    type  = "Guid"
+    value = "c6a06e79-8e36-4a52-8c66-bc3d7d5d2b1e"
+  }
+
+  property {
+    name  = "Count"
+    type  = "Int32"
+    value = "42"
+  }
+
+  property {
+    name  = "Total"
+    type  = "Int64"
+    value = "9223372036854775807"
+  }
+}
+`, template, rInt, rInt)
+}
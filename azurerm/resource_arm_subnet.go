@@ -3,10 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -69,6 +71,28 @@ func resourceArmSubnet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"private_endpoint_network_policies_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"private_link_service_network_policies_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"service_endpoint_policy_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Network", "serviceEndpointPolicies"),
+				},
+				Set: schema.HashString,
+			},
+
 			"delegation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -151,6 +175,18 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		AddressPrefix: &addressPrefix,
 	}
 
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		}
+
+		// the NAT Gateway is managed via the `azurerm_subnet_nat_gateway_association` resource, so it's retained here
+		if props := existing.SubnetPropertiesFormat; props != nil {
+			properties.NatGateway = props.NatGateway
+		}
+	}
+
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		nsgId := v.(string)
 		properties.NetworkSecurityGroup = &network.SecurityGroup{
@@ -188,9 +224,15 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	serviceEndpoints := expandSubnetServiceEndpoints(d)
 	properties.ServiceEndpoints = &serviceEndpoints
 
+	serviceEndpointPolicies := expandSubnetServiceEndpointPolicies(d)
+	properties.ServiceEndpointPolicies = &serviceEndpointPolicies
+
 	delegations := expandSubnetDelegation(d)
 	properties.Delegations = &delegations
 
+	properties.PrivateEndpointNetworkPolicies = expandSubnetNetworkPolicies(d.Get("private_endpoint_network_policies_enabled").(bool))
+	properties.PrivateLinkServiceNetworkPolicies = expandSubnetNetworkPolicies(d.Get("private_link_service_network_policies_enabled").(bool))
+
 	subnet := network.Subnet{
 		Name:                   &name,
 		SubnetPropertiesFormat: &properties,
@@ -269,10 +311,18 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		serviceEndpointPolicies := flattenSubnetServiceEndpointPolicies(props.ServiceEndpointPolicies)
+		if err := d.Set("service_endpoint_policy_ids", serviceEndpointPolicies); err != nil {
+			return fmt.Errorf("Error setting `service_endpoint_policy_ids`: %+v", err)
+		}
+
 		delegation := flattenSubnetDelegation(props.Delegations)
 		if err := d.Set("delegation", delegation); err != nil {
 			return fmt.Errorf("Error flattening `delegation`: %+v", err)
 		}
+
+		d.Set("private_endpoint_network_policies_enabled", flattenSubnetNetworkPolicies(props.PrivateEndpointNetworkPolicies))
+		d.Set("private_link_service_network_policies_enabled", flattenSubnetNetworkPolicies(props.PrivateLinkServiceNetworkPolicies))
 	}
 
 	return nil
@@ -362,6 +412,53 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
+// expandSubnetNetworkPolicies returns the value used to enable or disable the
+// Network Policies for Private Endpoints & Private Link Services within a Subnet
+func expandSubnetNetworkPolicies(enabled bool) *string {
+	if enabled {
+		return utils.String("Enabled")
+	}
+
+	return utils.String("Disabled")
+}
+
+// flattenSubnetNetworkPolicies returns whether the Network Policies are enabled,
+// which is the case when Azure hasn't got a value for them
+func flattenSubnetNetworkPolicies(input *string) bool {
+	return input == nil || !strings.EqualFold(*input, "Disabled")
+}
+
+func expandSubnetServiceEndpointPolicies(d *schema.ResourceData) []network.ServiceEndpointPolicy {
+	policyIds := d.Get("service_endpoint_policy_ids").(*schema.Set).List()
+	policies := make([]network.ServiceEndpointPolicy, 0)
+
+	for _, policyIdRaw := range policyIds {
+		if policyId, ok := policyIdRaw.(string); ok {
+			policies = append(policies, network.ServiceEndpointPolicy{
+				ID: utils.String(policyId),
+			})
+		}
+	}
+
+	return policies
+}
+
+func flattenSubnetServiceEndpointPolicies(policies *[]network.ServiceEndpointPolicy) []interface{} {
+	ids := make([]interface{}, 0)
+
+	if policies == nil {
+		return ids
+	}
+
+	for _, policy := range *policies {
+		if policy.ID != nil {
+			ids = append(ids, *policy.ID)
+		}
+	}
+
+	return ids
+}
+
 func flattenSubnetIPConfigurations(ipConfigurations *[]network.IPConfiguration) []string {
 	ips := make([]string, 0)
 
@@ -0,0 +1,289 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const azureSubnetServiceEndpointStoragePolicyResourceName = "azurerm_subnet_service_endpoint_storage_policy"
+
+// Storage Accounts are the only Service which can be scoped by a Service Endpoint Policy at this time
+const subnetServiceEndpointStoragePolicyService = "Microsoft.Storage"
+
+func resourceArmSubnetServiceEndpointStoragePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate,
+		Read:   resourceArmSubnetServiceEndpointStoragePolicyRead,
+		Update: resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate,
+		Delete: resourceArmSubnetServiceEndpointStoragePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"definition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 140),
+						},
+
+						"service_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceIDOfType("Microsoft.Storage", "storageAccounts"),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceEndpointPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Subnet Service Endpoint Storage Policy creation")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subnet Service Endpoint Storage Policy %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError(azureSubnetServiceEndpointStoragePolicyResourceName, *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+	definitions := expandSubnetServiceEndpointStoragePolicyDefinitions(d.Get("definition").([]interface{}))
+
+	azureRMLockByName(name, azureSubnetServiceEndpointStoragePolicyResourceName)
+	defer azureRMUnlockByName(name, azureSubnetServiceEndpointStoragePolicyResourceName)
+
+	parameters := network.ServiceEndpointPolicy{
+		Location: &location,
+		ServiceEndpointPolicyPropertiesFormat: &network.ServiceEndpointPolicyPropertiesFormat{
+			ServiceEndpointPolicyDefinitions: definitions,
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	policy, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if policy.ID == nil {
+		return fmt.Errorf("Cannot read Subnet Service Endpoint Storage Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*policy.ID)
+
+	return resourceArmSubnetServiceEndpointStoragePolicyRead(d, meta)
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceEndpointPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["serviceEndpointPolicies"]
+
+	policy, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			log.Printf("[DEBUG] Subnet Service Endpoint Storage Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := policy.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := policy.ServiceEndpointPolicyPropertiesFormat; props != nil {
+		if err := d.Set("definition", flattenSubnetServiceEndpointStoragePolicyDefinitions(props.ServiceEndpointPolicyDefinitions)); err != nil {
+			return fmt.Errorf("Error setting `definition`: %+v", err)
+		}
+
+		if err := d.Set("subnet_ids", flattenSubnetServiceEndpointStoragePolicySubnetIDs(props.Subnets)); err != nil {
+			return fmt.Errorf("Error setting `subnet_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceArmSubnetServiceEndpointStoragePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).serviceEndpointPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["serviceEndpointPolicies"]
+
+	azureRMLockByName(name, azureSubnetServiceEndpointStoragePolicyResourceName)
+	defer azureRMUnlockByName(name, azureSubnetServiceEndpointStoragePolicyResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Subnet Service Endpoint Storage Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandSubnetServiceEndpointStoragePolicyDefinitions(input []interface{}) *[]network.ServiceEndpointPolicyDefinition {
+	definitions := make([]network.ServiceEndpointPolicyDefinition, 0)
+
+	for _, definitionRaw := range input {
+		if definitionRaw == nil {
+			continue
+		}
+		definition := definitionRaw.(map[string]interface{})
+
+		resources := make([]string, 0)
+		for _, resource := range definition["service_resources"].(*schema.Set).List() {
+			resources = append(resources, resource.(string))
+		}
+
+		definitions = append(definitions, network.ServiceEndpointPolicyDefinition{
+			Name: utils.String(definition["name"].(string)),
+			ServiceEndpointPolicyDefinitionPropertiesFormat: &network.ServiceEndpointPolicyDefinitionPropertiesFormat{
+				Description:      utils.String(definition["description"].(string)),
+				Service:          utils.String(subnetServiceEndpointStoragePolicyService),
+				ServiceResources: &resources,
+			},
+		})
+	}
+
+	return &definitions
+}
+
+func flattenSubnetServiceEndpointStoragePolicyDefinitions(input *[]network.ServiceEndpointPolicyDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, definition := range *input {
+		output := make(map[string]interface{})
+
+		if definition.Name != nil {
+			output["name"] = *definition.Name
+		}
+
+		if props := definition.ServiceEndpointPolicyDefinitionPropertiesFormat; props != nil {
+			if props.Description != nil {
+				output["description"] = *props.Description
+			}
+
+			resources := make([]interface{}, 0)
+			if props.ServiceResources != nil {
+				for _, resource := range *props.ServiceResources {
+					resources = append(resources, resource)
+				}
+			}
+			output["service_resources"] = schema.NewSet(schema.HashString, resources)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenSubnetServiceEndpointStoragePolicySubnetIDs(input *[]network.Subnet) []string {
+	ids := make([]string, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, subnet := range *input {
+		if subnet.ID != nil {
+			ids = append(ids, *subnet.ID)
+		}
+	}
+
+	return ids
+}
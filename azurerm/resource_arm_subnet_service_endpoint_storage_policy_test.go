@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_subnet_service_endpoint_storage_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetServiceEndpointStoragePolicy_update(t *testing.T) {
+	resourceName := "azurerm_subnet_service_endpoint_storage_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.name", "storage"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.description", "Allow the acceptance test Storage Account"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.service_resources.#", "1"),
+					resource.TestCheckResourceAttr("azurerm_subnet.test", "service_endpoint_policy_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Subnet Service Endpoint Storage Policy: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).serviceEndpointPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subnet Service Endpoint Storage Policy %q (Resource Group: %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on serviceEndpointPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubnetServiceEndpointStoragePolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).serviceEndpointPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subnet_service_endpoint_storage_policy" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Subnet Service Endpoint Storage Policy still exists:\n%#v", resp.ServiceEndpointPolicyPropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMSubnetServiceEndpointStoragePolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "import" {
+  name                = "${azurerm_subnet_service_endpoint_storage_policy.test.name}"
+  location            = "${azurerm_subnet_service_endpoint_storage_policy.test.location}"
+  resource_group_name = "${azurerm_subnet_service_endpoint_storage_policy.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMSubnetServiceEndpointStoragePolicy_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_subnet_service_endpoint_storage_policy" "test" {
+  name                = "acctestSEP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  definition {
+    name              = "storage"
+    description       = "Allow the acceptance test Storage Account"
+    service_resources = ["${azurerm_storage_account.test.id}"]
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                        = "acctestsubnet%d"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  virtual_network_name        = "${azurerm_virtual_network.test.name}"
+  address_prefix              = "10.0.2.0/24"
+  service_endpoints           = ["Microsoft.Storage"]
+  service_endpoint_policy_ids = ["${azurerm_subnet_service_endpoint_storage_policy.test.id}"]
+}
+`, rInt, location, rString, rInt, rInt, rInt)
+}
@@ -5,10 +5,11 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +23,8 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmTrafficManagerEndpointCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -95,8 +98,9 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 			},
 
 			"min_child_endpoints": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			"geo_mappings": {
@@ -105,6 +109,49 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 				Optional: true,
 			},
 
+			"custom_header": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"subnet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						"last": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						"scope": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 128),
+						},
+					},
+				},
+			},
+
 			"endpoint_monitor_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -206,6 +253,14 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 		d.Set("endpoint_monitor_status", props.EndpointMonitorStatus)
 		d.Set("min_child_endpoints", props.MinChildEndpoints)
 		d.Set("geo_mappings", props.GeoMapping)
+
+		if err := d.Set("custom_header", flattenArmTrafficManagerEndpointCustomHeaders(props.CustomHeaders)); err != nil {
+			return fmt.Errorf("Error setting `custom_header`: %+v", err)
+		}
+
+		if err := d.Set("subnet", flattenArmTrafficManagerEndpointSubnets(props.Subnets)); err != nil {
+			return fmt.Errorf("Error setting `subnet`: %+v", err)
+		}
 	}
 
 	return nil
@@ -280,5 +335,151 @@ func getArmTrafficManagerEndpointProperties(d *schema.ResourceData) *trafficmana
 		endpointProps.MinChildEndpoints = &mci64
 	}
 
+	headers := make([]trafficmanager.EndpointPropertiesCustomHeadersItem, 0)
+	for _, v := range d.Get("custom_header").([]interface{}) {
+		header := v.(map[string]interface{})
+		headers = append(headers, trafficmanager.EndpointPropertiesCustomHeadersItem{
+			Name:  utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+	endpointProps.CustomHeaders = &headers
+
+	endpointProps.Subnets = expandArmTrafficManagerEndpointSubnets(d.Get("subnet").([]interface{}))
+
 	return &endpointProps
 }
+
+func expandArmTrafficManagerEndpointSubnets(input []interface{}) *[]trafficmanager.EndpointPropertiesSubnetsItem {
+	subnets := make([]trafficmanager.EndpointPropertiesSubnetsItem, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		subnet := trafficmanager.EndpointPropertiesSubnetsItem{
+			First: utils.String(raw["first"].(string)),
+		}
+
+		// a `subnet` with neither a `last` address nor a `scope` matches only the `first` address
+		if last := raw["last"].(string); last != "" {
+			subnet.Last = utils.String(last)
+		}
+		if scope := raw["scope"].(int); scope != 0 {
+			subnet.Scope = utils.Int32(int32(scope))
+		}
+
+		subnets = append(subnets, subnet)
+	}
+
+	return &subnets
+}
+
+func flattenArmTrafficManagerEndpointSubnets(input *[]trafficmanager.EndpointPropertiesSubnetsItem) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, subnet := range *input {
+		output := make(map[string]interface{})
+		if subnet.First != nil {
+			output["first"] = *subnet.First
+		}
+		if subnet.Last != nil {
+			output["last"] = *subnet.Last
+		}
+		if subnet.Scope != nil {
+			output["scope"] = int(*subnet.Scope)
+		}
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenArmTrafficManagerEndpointCustomHeaders(input *[]trafficmanager.EndpointPropertiesCustomHeadersItem) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, header := range *input {
+		output := make(map[string]interface{})
+		if header.Name != nil {
+			output["name"] = *header.Name
+		}
+		if header.Value != nil {
+			output["value"] = *header.Value
+		}
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func resourceArmTrafficManagerEndpointCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("min_child_endpoints"); ok && v.(int) != 0 {
+		if endpointType := d.Get("type").(string); endpointType != "nestedEndpoints" {
+			return fmt.Errorf("`min_child_endpoints` can only be set when `type` is `nestedEndpoints`, got %q", endpointType)
+		}
+	}
+
+	for _, v := range d.Get("subnet").([]interface{}) {
+		subnet, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if last, ok := subnet["last"].(string); ok && last != "" {
+			if scope, ok := subnet["scope"].(int); ok && scope != 0 {
+				return fmt.Errorf("only one of `last` and `scope` can be specified within a `subnet` block")
+			}
+		}
+	}
+
+	if !d.HasChange("geo_mappings") || !d.NewValueKnown("geo_mappings") {
+		return nil
+	}
+
+	geoMappings := make([]string, 0)
+	for _, v := range d.Get("geo_mappings").([]interface{}) {
+		mapping, ok := v.(string)
+		if !ok || mapping == "" {
+			// not known until apply
+			return nil
+		}
+		geoMappings = append(geoMappings, mapping)
+	}
+	if len(geoMappings) == 0 {
+		return nil
+	}
+
+	client := meta.(*ArmClient).trafficManagerGeographialHierarchiesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	hierarchy, err := client.GetDefault(ctx)
+	if err != nil {
+		return fmt.Errorf("Error loading Traffic Manager Geographical Hierarchies: %+v", err)
+	}
+
+	var codes map[string]bool
+	if props := hierarchy.GeographicHierarchyProperties; props != nil {
+		codes = flattenGeographicalRegionCodes(props.GeographicHierarchy)
+	}
+
+	return validateTrafficManagerEndpointGeoMappings(geoMappings, codes)
+}
+
+func validateTrafficManagerEndpointGeoMappings(geoMappings []string, codes map[string]bool) error {
+	seen := make(map[string]bool)
+	for _, mapping := range geoMappings {
+		if !codes[mapping] {
+			return fmt.Errorf("%q in `geo_mappings` isn't a known Traffic Manager Geographic Location code - the `azurerm_traffic_manager_geographical_location` Data Source can be used to look these up", mapping)
+		}
+
+		if seen[mapping] {
+			return fmt.Errorf("%q is specified more than once in `geo_mappings`", mapping)
+		}
+		seen[mapping] = true
+	}
+
+	return nil
+}
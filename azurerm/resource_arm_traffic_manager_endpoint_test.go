@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"reflect"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMTrafficManagerEndpoint_basic(t *testing.T) {
@@ -217,12 +220,171 @@ func TestAccAzureRMTrafficManagerEndpoint_nestedEndpoints(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMTrafficManagerEndpointExists("azurerm_traffic_manager_endpoint.nested"),
 					testCheckAzureRMTrafficManagerEndpointExists("azurerm_traffic_manager_endpoint.externalChild"),
+					resource.TestCheckResourceAttr("azurerm_traffic_manager_endpoint.nested", "min_child_endpoints", "1"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAzureRMTrafficManagerEndpoint_customHeaders(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerEndpoint_customHeaders(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_header.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_header.0.name", "host"),
+					resource.TestCheckResourceAttr(resourceName, "custom_header.0.value", "www.bing.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficManagerEndpoint_subnets(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerEndpoint_subnets(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.first", "1.2.3.0"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.scope", "24"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.first", "11.12.13.14"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.1.last", "11.12.13.20"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.2.first", "21.22.23.24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandArmTrafficManagerEndpointSubnets(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"first": "1.2.3.0",
+			"last":  "",
+			"scope": 24,
+		},
+		map[string]interface{}{
+			"first": "11.12.13.14",
+			"last":  "11.12.13.20",
+			"scope": 0,
+		},
+		map[string]interface{}{
+			"first": "21.22.23.24",
+			"last":  "",
+			"scope": 0,
+		},
+	}
+
+	subnets := *expandArmTrafficManagerEndpointSubnets(input)
+	if len(subnets) != 3 {
+		t.Fatalf("Expected 3 subnets but got %d", len(subnets))
+	}
+
+	if subnets[0].Scope == nil || *subnets[0].Scope != 24 || subnets[0].Last != nil {
+		t.Fatalf("Expected the first subnet to have a scope of 24 and no last address")
+	}
+
+	if subnets[1].Last == nil || *subnets[1].Last != "11.12.13.20" || subnets[1].Scope != nil {
+		t.Fatalf("Expected the second subnet to have a last address of 11.12.13.20 and no scope")
+	}
+
+	if subnets[2].Last != nil || subnets[2].Scope != nil {
+		t.Fatalf("Expected the third subnet to have neither a last address nor a scope")
+	}
+
+	output := flattenArmTrafficManagerEndpointSubnets(&subnets)
+	expected := []interface{}{
+		map[string]interface{}{
+			"first": "1.2.3.0",
+			"scope": 24,
+		},
+		map[string]interface{}{
+			"first": "11.12.13.14",
+			"last":  "11.12.13.20",
+		},
+		map[string]interface{}{
+			"first": "21.22.23.24",
+		},
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, output)
+	}
+}
+
+func TestValidateTrafficManagerEndpointGeoMappings(t *testing.T) {
+	hierarchy := &trafficmanager.Region{
+		Code: utils.String("WORLD"),
+		Regions: &[]trafficmanager.Region{
+			{
+				Code: utils.String("GEO-EU"),
+				Regions: &[]trafficmanager.Region{
+					{Code: utils.String("GB")},
+					{
+						Code: utils.String("FR"),
+						Regions: &[]trafficmanager.Region{
+							{Code: utils.String("FR-75")},
+						},
+					},
+				},
+			},
+			{Code: utils.String("GEO-NA")},
+		},
+	}
+	codes := flattenGeographicalRegionCodes(hierarchy)
+
+	cases := []struct {
+		GeoMappings []string
+		ExpectError bool
+	}{
+		{GeoMappings: []string{"WORLD"}, ExpectError: false},
+		{GeoMappings: []string{"GEO-EU", "GEO-NA"}, ExpectError: false},
+		{GeoMappings: []string{"GB", "FR-75"}, ExpectError: false},
+		{GeoMappings: []string{"gb"}, ExpectError: true},
+		{GeoMappings: []string{"GB", "XX"}, ExpectError: true},
+		{GeoMappings: []string{"GB", "GB"}, ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		err := validateTrafficManagerEndpointGeoMappings(tc.GeoMappings, codes)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.GeoMappings)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.GeoMappings, err)
+		}
+	}
+}
+
 func TestAccAzureRMTrafficManagerEndpoint_location(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_endpoint.test"
 	ri := tf.AccRandTimeInt()
@@ -728,6 +890,94 @@ resource "azurerm_traffic_manager_endpoint" "externalChild" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
 }
 
+func testAccAzureRMTrafficManagerEndpoint_customHeaders(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Priority"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "test" {
+  name                = "acctestend-external%d"
+  type                = "externalEndpoints"
+  target              = "www.bing.com"
+  priority            = 1
+  profile_name        = "${azurerm_traffic_manager_profile.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  custom_header {
+    name  = "host"
+    value = "www.bing.com"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMTrafficManagerEndpoint_subnets(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Subnet"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "test" {
+  name                = "acctestend-external%d"
+  type                = "externalEndpoints"
+  target              = "www.bing.com"
+  profile_name        = "${azurerm_traffic_manager_profile.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    first = "1.2.3.0"
+    scope = 24
+  }
+
+  subnet {
+    first = "11.12.13.14"
+    last  = "11.12.13.20"
+  }
+
+  subnet {
+    first = "21.22.23.24"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
 func testAccAzureRMTrafficManagerEndpoint_location(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
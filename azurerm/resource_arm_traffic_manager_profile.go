@@ -4,13 +4,15 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -52,9 +54,17 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 					string(trafficmanager.Weighted),
 					string(trafficmanager.Performance),
 					string(trafficmanager.Priority),
+					string(trafficmanager.MultiValue),
+					string(trafficmanager.Subnet),
 				}, false),
 			},
 
+			"max_return": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 8),
+			},
+
 			"dns_config": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -105,6 +115,32 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"custom_header": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+						"expected_status_code_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateTrafficManagerStatusCodeRange,
+							},
+						},
 					},
 				},
 				Set: resourceAzureRMTrafficManagerMonitorConfigHash,
@@ -127,6 +163,22 @@ func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta i
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
+	monitorConfig := expandArmTrafficManagerMonitorConfig(d)
+	if strings.EqualFold(string(monitorConfig.Protocol), string(trafficmanager.TCP)) {
+		if monitorConfig.CustomHeaders != nil || monitorConfig.ExpectedStatusCodeRanges != nil {
+			return fmt.Errorf("`custom_header` and `expected_status_code_ranges` can only be set in the `monitor_config` when the `protocol` is `HTTP` or `HTTPS`")
+		}
+	}
+
+	routingMethod := d.Get("traffic_routing_method").(string)
+	maxReturn := d.Get("max_return").(int)
+	if routingMethod == string(trafficmanager.MultiValue) && maxReturn == 0 {
+		return fmt.Errorf("`max_return` must be specified when `traffic_routing_method` is `MultiValue`")
+	}
+	if routingMethod != string(trafficmanager.MultiValue) && maxReturn != 0 {
+		return fmt.Errorf("`max_return` can only be specified when `traffic_routing_method` is `MultiValue`")
+	}
+
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
@@ -191,6 +243,7 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	d.Set("name", resp.Name)
 	d.Set("profile_status", profile.ProfileStatus)
 	d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
+	d.Set("max_return", profile.MaxReturn)
 
 	dnsFlat := flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig)
 	d.Set("dns_config", schema.NewSet(resourceAzureRMTrafficManagerDNSConfigHash, dnsFlat))
@@ -235,6 +288,10 @@ func getArmTrafficManagerProfileProperties(d *schema.ResourceData) *trafficmanag
 		MonitorConfig:        expandArmTrafficManagerMonitorConfig(d),
 	}
 
+	if maxReturn := d.Get("max_return").(int); maxReturn != 0 {
+		props.MaxReturn = utils.Int64(int64(maxReturn))
+	}
+
 	if status, ok := d.GetOk("profile_status"); ok {
 		s := status.(string)
 		props.ProfileStatus = trafficmanager.ProfileStatus(s)
@@ -251,11 +308,40 @@ func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanage
 	port := int64(monitor["port"].(int))
 	path := monitor["path"].(string)
 
-	return &trafficmanager.MonitorConfig{
+	config := trafficmanager.MonitorConfig{
 		Protocol: trafficmanager.MonitorProtocol(proto),
 		Port:     &port,
 		Path:     &path,
 	}
+
+	headers := make([]trafficmanager.MonitorConfigCustomHeadersItem, 0)
+	for _, v := range monitor["custom_header"].([]interface{}) {
+		header := v.(map[string]interface{})
+		headers = append(headers, trafficmanager.MonitorConfigCustomHeadersItem{
+			Name:  utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+	if len(headers) > 0 {
+		config.CustomHeaders = &headers
+	}
+
+	ranges := make([]trafficmanager.MonitorConfigExpectedStatusCodeRangesItem, 0)
+	for _, v := range monitor["expected_status_code_ranges"].([]interface{}) {
+		// these have already been validated by validateTrafficManagerStatusCodeRange
+		parts := strings.Split(v.(string), "-")
+		min, _ := strconv.Atoi(parts[0])
+		max, _ := strconv.Atoi(parts[1])
+		ranges = append(ranges, trafficmanager.MonitorConfigExpectedStatusCodeRangesItem{
+			Min: utils.Int32(int32(min)),
+			Max: utils.Int32(int32(max)),
+		})
+	}
+	if len(ranges) > 0 {
+		config.ExpectedStatusCodeRanges = &ranges
+	}
+
+	return &config
 }
 
 func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficmanager.DNSConfig {
@@ -290,6 +376,31 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 		result["path"] = *cfg.Path
 	}
 
+	headers := make([]interface{}, 0)
+	if cfg.CustomHeaders != nil {
+		for _, header := range *cfg.CustomHeaders {
+			output := make(map[string]interface{})
+			if header.Name != nil {
+				output["name"] = *header.Name
+			}
+			if header.Value != nil {
+				output["value"] = *header.Value
+			}
+			headers = append(headers, output)
+		}
+	}
+	result["custom_header"] = headers
+
+	ranges := make([]interface{}, 0)
+	if cfg.ExpectedStatusCodeRanges != nil {
+		for _, r := range *cfg.ExpectedStatusCodeRanges {
+			if r.Min != nil && r.Max != nil {
+				ranges = append(ranges, fmt.Sprintf("%d-%d", *r.Min, *r.Max))
+			}
+		}
+	}
+	result["expected_status_code_ranges"] = ranges
+
 	return []interface{}{result}
 }
 
@@ -314,7 +425,53 @@ func resourceAzureRMTrafficManagerMonitorConfigHash(v interface{}) int {
 		if v, ok := m["path"]; ok && v != "" {
 			buf.WriteString(fmt.Sprintf("%s-", m["path"].(string)))
 		}
+
+		if headers, ok := m["custom_header"].([]interface{}); ok {
+			for _, v := range headers {
+				if header, ok := v.(map[string]interface{}); ok {
+					buf.WriteString(fmt.Sprintf("%s:%s-", header["name"].(string), header["value"].(string)))
+				}
+			}
+		}
+
+		if ranges, ok := m["expected_status_code_ranges"].([]interface{}); ok {
+			for _, v := range ranges {
+				buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+			}
+		}
 	}
 
 	return hashcode.String(buf.String())
 }
+
+func validateTrafficManagerStatusCodeRange(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	parts := strings.Split(v, "-")
+	if len(parts) != 2 {
+		errors = append(errors, fmt.Errorf("%q must be a range in the format `min-max` (e.g. `200-299`), got %q", k, v))
+		return
+	}
+
+	min, err := strconv.Atoi(parts[0])
+	if err != nil || min < 100 || min > 999 {
+		errors = append(errors, fmt.Errorf("%q must start with a status code between 100 and 999, got %q", k, v))
+		return
+	}
+
+	max, err := strconv.Atoi(parts[1])
+	if err != nil || max < 100 || max > 999 {
+		errors = append(errors, fmt.Errorf("%q must end with a status code between 100 and 999, got %q", k, v))
+		return
+	}
+
+	if min > max {
+		errors = append(errors, fmt.Errorf("%q must start with a status code no greater than the one it ends with, got %q", k, v))
+	}
+
+	return
+}
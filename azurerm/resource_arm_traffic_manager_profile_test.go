@@ -105,6 +105,91 @@ func TestAccAzureRMTrafficManagerProfile_weighted(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerProfile_monitorCustomHeaders(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_profile.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerProfile_monitorCustomHeaders(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_config.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMTrafficManagerProfile_weighted(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficManagerProfile_multiValue(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_profile.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTrafficManagerProfile_multiValue(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_method", "MultiValue"),
+					resource.TestCheckResourceAttr(resourceName, "max_return", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateTrafficManagerStatusCodeRange(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{Value: "", Errors: 1},
+		{Value: "200", Errors: 1},
+		{Value: "200-", Errors: 1},
+		{Value: "abc-299", Errors: 1},
+		{Value: "99-200", Errors: 1},
+		{Value: "200-1000", Errors: 1},
+		{Value: "299-200", Errors: 1},
+		{Value: "200-299-300", Errors: 1},
+		{Value: "200-200", Errors: 0},
+		{Value: "200-299", Errors: 0},
+		{Value: "100-999", Errors: 0},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateTrafficManagerStatusCodeRange(tc.Value, "expected_status_code_ranges")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected validateTrafficManagerStatusCodeRange to return %d errors for %q but got %d", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestAccAzureRMTrafficManagerProfile_weightedTCP(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
 	ri := tf.AccRandTimeInt()
@@ -440,6 +525,65 @@ resource "azurerm_traffic_manager_profile" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMTrafficManagerProfile_monitorCustomHeaders(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Weighted"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol                    = "https"
+    port                        = 443
+    path                        = "/health"
+    expected_status_code_ranges = ["200-202", "301-302"]
+
+    custom_header {
+      name  = "host"
+      value = "www.example.com"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMTrafficManagerProfile_multiValue(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "MultiValue"
+  max_return             = 2
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMTrafficManagerProfile_weightedTCP(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"allow_hub_to_remote_vnet_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_remote_vnet_to_use_hub_vnet_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	hubClient := meta.(*ArmClient).virtualHubClient
+	client := meta.(*ArmClient).hubVnetConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Virtual Hub Connection creation.")
+
+	name := d.Get("name").(string)
+	virtualHubId := d.Get("virtual_hub_id").(string)
+
+	parsedHubId, err := parseAzureResourceID(virtualHubId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := parsedHubId.ResourceGroup
+	virtualHubName := parsedHubId.Path["virtualHubs"]
+
+	azureRMLockByName(virtualHubName, virtualHubResourceName)
+	defer azureRMUnlockByName(virtualHubName, virtualHubResourceName)
+
+	hub, err := hubClient.Get(ctx, resourceGroup, virtualHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return fmt.Errorf("Virtual Hub %q (Resource Group %q) was not found!", virtualHubName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHubName, resourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Virtual Hub %q (Resource Group %q)", virtualHubName, resourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if props.VirtualNetworkConnections != nil {
+		for _, connection := range *props.VirtualNetworkConnections {
+			if connection.Name != nil && *connection.Name == name {
+				if requireResourcesToBeImported && d.IsNewResource() && connection.ID != nil {
+					return tf.ImportAsExistsError("azurerm_virtual_hub_connection", *connection.ID)
+				}
+
+				continue
+			}
+
+			connections = append(connections, connection)
+		}
+	}
+
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_vnet_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_vnet_to_use_hub_vnet_gateways").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("enable_internet_security").(bool)),
+		},
+	})
+	props.VirtualNetworkConnections = &connections
+
+	future, err := hubClient.CreateOrUpdate(ctx, resourceGroup, virtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, hubClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, virtualHubName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Connection %q for Virtual Hub %q (Resource Group %q) ID", name, virtualHubName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).hubVnetConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualHubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, virtualHubName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q for Virtual Hub %q (Resource Group %q) was not found - removing from state!", name, virtualHubName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q for Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("virtual_hub_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s", id.SubscriptionID, resourceGroup, virtualHubName))
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+
+		d.Set("allow_hub_to_remote_vnet_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_vnet_to_use_hub_vnet_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	hubClient := meta.(*ArmClient).virtualHubClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualHubName := id.Path["virtualHubs"]
+	name := id.Path["hubVirtualNetworkConnections"]
+
+	azureRMLockByName(virtualHubName, virtualHubResourceName)
+	defer azureRMUnlockByName(virtualHubName, virtualHubResourceName)
+
+	hub, err := hubClient.Get(ctx, resourceGroup, virtualHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			// the Virtual Hub's been deleted, so the Connection's gone too
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", virtualHubName, resourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil || props.VirtualNetworkConnections == nil {
+		return nil
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, connection := range *props.VirtualNetworkConnections {
+		if connection.Name != nil && *connection.Name == name {
+			continue
+		}
+
+		connections = append(connections, connection)
+	}
+	props.VirtualNetworkConnections = &connections
+
+	future, err := hubClient.CreateOrUpdate(ctx, resourceGroup, virtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error removing Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, hubClient.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, virtualHubName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -6,7 +6,7 @@ import (
 	"log"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -194,6 +194,42 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							Set: hashVirtualNetworkGatewayRevokedCert,
 						},
 
+						"aad_tenant": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.root_certificate",
+								"vpn_client_configuration.0.revoked_certificate",
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
+						"aad_audience": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.root_certificate",
+								"vpn_client_configuration.0.revoked_certificate",
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validate.UUID,
+						},
+
+						"aad_issuer": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ConflictsWith: []string{
+								"vpn_client_configuration.0.root_certificate",
+								"vpn_client_configuration.0.revoked_certificate",
+								"vpn_client_configuration.0.radius_server_address",
+								"vpn_client_configuration.0.radius_server_secret",
+							},
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
 						"radius_server_address": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -205,8 +241,9 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 						},
 
 						"radius_server_secret": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
 							ConflictsWith: []string{
 								"vpn_client_configuration.0.root_certificate",
 								"vpn_client_configuration.0.revoked_certificate",
@@ -229,6 +266,36 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 				},
 			},
 
+			"custom_route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.CIDR,
+							},
+						},
+					},
+				},
+			},
+
+			"generation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VpnGatewayGenerationGeneration1),
+					string(network.VpnGatewayGenerationGeneration2),
+					string(network.VpnGatewayGenerationNone),
+				}, false),
+			},
+
 			"bgp_settings": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -352,6 +419,7 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 
 	if gw := resp.VirtualNetworkGatewayPropertiesFormat; gw != nil {
 		d.Set("type", string(gw.GatewayType))
+		d.Set("generation", string(gw.VpnGatewayGeneration))
 		d.Set("enable_bgp", gw.EnableBgp)
 		d.Set("active_active", gw.ActiveActive)
 
@@ -376,6 +444,10 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 			return fmt.Errorf("Error setting `vpn_client_configuration`: %+v", err)
 		}
 
+		if err := d.Set("custom_route", flattenArmVirtualNetworkGatewayCustomRoutes(gw.CustomRoutes)); err != nil {
+			return fmt.Errorf("Error setting `custom_route`: %+v", err)
+		}
+
 		bgpSettingsFlat := flattenArmVirtualNetworkGatewayBgpSettings(gw.BgpSettings)
 		if err := d.Set("bgp_settings", bgpSettingsFlat); err != nil {
 			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
@@ -415,12 +487,14 @@ func getArmVirtualNetworkGatewayProperties(d *schema.ResourceData) (*network.Vir
 	activeActive := d.Get("active_active").(bool)
 
 	props := &network.VirtualNetworkGatewayPropertiesFormat{
-		GatewayType:      gatewayType,
-		VpnType:          vpnType,
-		EnableBgp:        &enableBgp,
-		ActiveActive:     &activeActive,
-		Sku:              expandArmVirtualNetworkGatewaySku(d),
-		IPConfigurations: expandArmVirtualNetworkGatewayIPConfigurations(d),
+		GatewayType:          gatewayType,
+		VpnType:              vpnType,
+		EnableBgp:            &enableBgp,
+		ActiveActive:         &activeActive,
+		Sku:                  expandArmVirtualNetworkGatewaySku(d),
+		IPConfigurations:     expandArmVirtualNetworkGatewayIPConfigurations(d),
+		CustomRoutes:         expandArmVirtualNetworkGatewayCustomRoutes(d.Get("custom_route").([]interface{})),
+		VpnGatewayGeneration: network.VpnGatewayGeneration(d.Get("generation").(string)),
 	}
 
 	if gatewayDefaultSiteID := d.Get("default_local_network_gateway_id").(string); gatewayDefaultSiteID != "" {
@@ -513,6 +587,20 @@ func expandArmVirtualNetworkGatewayIPConfigurations(d *schema.ResourceData) *[]n
 	return &ipConfigs
 }
 
+func expandArmVirtualNetworkGatewayCustomRoutes(input []interface{}) *network.AddressSpace {
+	prefixes := make([]string, 0)
+	if len(input) > 0 && input[0] != nil {
+		route := input[0].(map[string]interface{})
+		for _, v := range route["address_prefixes"].([]interface{}) {
+			prefixes = append(prefixes, v.(string))
+		}
+	}
+
+	return &network.AddressSpace{
+		AddressPrefixes: &prefixes,
+	}
+}
+
 func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *network.VpnClientConfiguration {
 	configSets := d.Get("vpn_client_configuration").([]interface{})
 	conf := configSets[0].(map[string]interface{})
@@ -560,7 +648,7 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 	confRadiusServerAddress := conf["radius_server_address"].(string)
 	confRadiusServerSecret := conf["radius_server_secret"].(string)
 
-	return &network.VpnClientConfiguration{
+	config := &network.VpnClientConfiguration{
 		VpnClientAddressPool: &network.AddressSpace{
 			AddressPrefixes: &addresses,
 		},
@@ -570,6 +658,14 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 		RadiusServerAddress:          &confRadiusServerAddress,
 		RadiusServerSecret:           &confRadiusServerSecret,
 	}
+
+	if tenant := conf["aad_tenant"].(string); tenant != "" {
+		config.AadTenant = utils.String(tenant)
+		config.AadAudience = utils.String(conf["aad_audience"].(string))
+		config.AadIssuer = utils.String(conf["aad_issuer"].(string))
+	}
+
+	return config
 }
 
 func expandArmVirtualNetworkGatewaySku(d *schema.ResourceData) *network.VirtualNetworkGatewaySku {
@@ -635,6 +731,23 @@ func flattenArmVirtualNetworkGatewayIPConfigurations(ipConfigs *[]network.Virtua
 	return flat
 }
 
+func flattenArmVirtualNetworkGatewayCustomRoutes(input *network.AddressSpace) []interface{} {
+	if input == nil || input.AddressPrefixes == nil || len(*input.AddressPrefixes) == 0 {
+		return []interface{}{}
+	}
+
+	prefixes := make([]interface{}, 0)
+	for _, v := range *input.AddressPrefixes {
+		prefixes = append(prefixes, v)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"address_prefixes": prefixes,
+		},
+	}
+}
+
 func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfiguration) []interface{} {
 	if cfg == nil {
 		return []interface{}{}
@@ -692,6 +805,18 @@ func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfig
 		flat["radius_server_secret"] = *v
 	}
 
+	if v := cfg.AadTenant; v != nil {
+		flat["aad_tenant"] = *v
+	}
+
+	if v := cfg.AadAudience; v != nil {
+		flat["aad_audience"] = *v
+	}
+
+	if v := cfg.AadIssuer; v != nil {
+		flat["aad_issuer"] = *v
+	}
+
 	return []interface{}{flat}
 }
 
@@ -793,7 +918,91 @@ func resourceArmVirtualNetworkGatewayCustomizeDiff(diff *schema.ResourceDiff, _
 			if !hasRadiusAddress && hasRadiusSecret {
 				return fmt.Errorf("if radius_server_secret is set radius_server_address must also be set")
 			}
+
+			hasAadTenant := vpnClientConfig["aad_tenant"] != ""
+			hasAadAudience := vpnClientConfig["aad_audience"] != ""
+			hasAadIssuer := vpnClientConfig["aad_issuer"] != ""
+
+			if (hasAadTenant || hasAadAudience || hasAadIssuer) && !(hasAadTenant && hasAadAudience && hasAadIssuer) {
+				return fmt.Errorf("aad_tenant, aad_audience and aad_issuer must all be set when using Azure Active Directory authentication")
+			}
+		}
+	}
+
+	return validateArmVirtualNetworkGatewaySkuFeatures(
+		diff.Get("type").(string),
+		diff.Get("vpn_type").(string),
+		diff.Get("sku").(string),
+		diff.Get("generation").(string),
+		diff.Get("active_active").(bool),
+		diff.Get("enable_bgp").(bool),
+		diff.Get("vpn_client_configuration").([]interface{}))
+}
+
+// validateArmVirtualNetworkGatewaySkuFeatures checks the features used are supported by the type & SKU of the gateway,
+// since the API only rejects these part-way through provisioning (which can take upwards of 30 minutes)
+func validateArmVirtualNetworkGatewaySkuFeatures(gatewayType, vpnType, sku, generation string, activeActive, enableBgp bool, vpnClientConfigs []interface{}) error {
+	// the SKU isn't known until apply, so there's nothing to check
+	if sku == "" {
+		return nil
+	}
+
+	isBasic := strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuTierBasic))
+	if isBasic && activeActive {
+		return fmt.Errorf("`active_active` is not supported by the %q SKU", sku)
+	}
+	if isBasic && enableBgp {
+		return fmt.Errorf("`enable_bgp` is not supported by the %q SKU", sku)
+	}
+
+	isExpressRoute := strings.EqualFold(gatewayType, string(network.VirtualNetworkGatewayTypeExpressRoute))
+	if isExpressRoute && generation != "" && generation != string(network.VpnGatewayGenerationNone) {
+		return fmt.Errorf("`generation` must be %q when `type` is %q", string(network.VpnGatewayGenerationNone), gatewayType)
+	}
+
+	// of the SKUs supported by the provider only VpnGw2 and VpnGw3 are available as Generation2
+	if generation == string(network.VpnGatewayGenerationGeneration2) {
+		if !strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuNameVpnGw2)) && !strings.EqualFold(sku, string(network.VirtualNetworkGatewaySkuNameVpnGw3)) {
+			return fmt.Errorf("`generation` %q is not supported by the %q SKU", generation, sku)
 		}
 	}
+
+	if len(vpnClientConfigs) == 0 || vpnClientConfigs[0] == nil {
+		return nil
+	}
+
+	if isExpressRoute {
+		return fmt.Errorf("`vpn_client_configuration` can only be used with a `type` of %q", string(network.VirtualNetworkGatewayTypeVpn))
+	}
+	if strings.EqualFold(vpnType, string(network.PolicyBased)) {
+		return fmt.Errorf("`vpn_client_configuration` can only be used with a `vpn_type` of %q", string(network.RouteBased))
+	}
+
+	config := vpnClientConfigs[0].(map[string]interface{})
+	if isBasic && config["radius_server_address"].(string) != "" {
+		return fmt.Errorf("RADIUS authentication is not supported by the %q SKU", sku)
+	}
+
+	// Azure Active Directory authentication is only available to OpenVPN clients
+	protocols := config["vpn_client_protocols"].(*schema.Set).List()
+	if tenant, ok := config["aad_tenant"].(string); ok && tenant != "" {
+		if len(protocols) != 1 || !strings.EqualFold(protocols[0].(string), string(network.OpenVPN)) {
+			return fmt.Errorf("Azure Active Directory authentication requires `vpn_client_protocols` to be [%q]", string(network.OpenVPN))
+		}
+	}
+
+	// OpenVPN is only available on the VpnGw SKUs, and the Basic SKU only supports SSTP
+	supportsOpenVPN := strings.HasPrefix(strings.ToLower(sku), "vpngw")
+	for _, v := range protocols {
+		protocol := v.(string)
+
+		if strings.EqualFold(protocol, string(network.OpenVPN)) && !supportsOpenVPN {
+			return fmt.Errorf("The %q VPN Client Protocol is not supported by the %q SKU", protocol, sku)
+		}
+		if strings.EqualFold(protocol, string(network.IkeV2)) && isBasic {
+			return fmt.Errorf("The %q VPN Client Protocol is not supported by the %q SKU", protocol, sku)
+		}
+	}
+
 	return nil
 }
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -95,6 +95,12 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Computed: true,
 			},
 
+			"express_route_gateway_bypass": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"routing_weight": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -108,123 +114,127 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Sensitive: true,
 			},
 
-			"ipsec_policy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"dh_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DHGroup1),
-								string(network.DHGroup14),
-								string(network.DHGroup2),
-								string(network.DHGroup2048),
-								string(network.DHGroup24),
-								string(network.ECP256),
-								string(network.ECP384),
-								string(network.None),
-							}, true),
-						},
-
-						"ike_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.AES128),
-								string(network.AES192),
-								string(network.AES256),
-								string(network.DES),
-								string(network.DES3),
-							}, true),
-						},
-
-						"ike_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IkeIntegrityGCMAES128),
-								string(network.IkeIntegrityGCMAES256),
-								string(network.IkeIntegrityMD5),
-								string(network.IkeIntegritySHA1),
-								string(network.IkeIntegritySHA256),
-								string(network.IkeIntegritySHA384),
-							}, true),
-						},
-
-						"ipsec_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecEncryptionAES128),
-								string(network.IpsecEncryptionAES192),
-								string(network.IpsecEncryptionAES256),
-								string(network.IpsecEncryptionDES),
-								string(network.IpsecEncryptionDES3),
-								string(network.IpsecEncryptionGCMAES128),
-								string(network.IpsecEncryptionGCMAES192),
-								string(network.IpsecEncryptionGCMAES256),
-								string(network.IpsecEncryptionNone),
-							}, true),
-						},
-
-						"ipsec_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecIntegrityGCMAES128),
-								string(network.IpsecIntegrityGCMAES192),
-								string(network.IpsecIntegrityGCMAES256),
-								string(network.IpsecIntegrityMD5),
-								string(network.IpsecIntegritySHA1),
-								string(network.IpsecIntegritySHA256),
-							}, true),
-						},
-
-						"pfs_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.PfsGroupECP256),
-								string(network.PfsGroupECP384),
-								string(network.PfsGroupNone),
-								string(network.PfsGroupPFS1),
-								string(network.PfsGroupPFS2),
-								string(network.PfsGroupPFS2048),
-								string(network.PfsGroupPFS24),
-							}, true),
-						},
-
-						"sa_datasize": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(1024),
-						},
-
-						"sa_lifetime": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(300),
-						},
-					},
-				},
-			},
+			"ipsec_policy": ipsecPolicySchema(),
 
 			"tags": tagsSchema(),
 		},
 	}
 }
 
+func ipsecPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dh_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.DHGroup1),
+						string(network.DHGroup14),
+						string(network.DHGroup2),
+						string(network.DHGroup2048),
+						string(network.DHGroup24),
+						string(network.ECP256),
+						string(network.ECP384),
+						string(network.None),
+					}, true),
+				},
+
+				"ike_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.AES128),
+						string(network.AES192),
+						string(network.AES256),
+						string(network.DES),
+						string(network.DES3),
+					}, true),
+				},
+
+				"ike_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IkeIntegrityGCMAES128),
+						string(network.IkeIntegrityGCMAES256),
+						string(network.IkeIntegrityMD5),
+						string(network.IkeIntegritySHA1),
+						string(network.IkeIntegritySHA256),
+						string(network.IkeIntegritySHA384),
+					}, true),
+				},
+
+				"ipsec_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecEncryptionAES128),
+						string(network.IpsecEncryptionAES192),
+						string(network.IpsecEncryptionAES256),
+						string(network.IpsecEncryptionDES),
+						string(network.IpsecEncryptionDES3),
+						string(network.IpsecEncryptionGCMAES128),
+						string(network.IpsecEncryptionGCMAES192),
+						string(network.IpsecEncryptionGCMAES256),
+						string(network.IpsecEncryptionNone),
+					}, true),
+				},
+
+				"ipsec_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecIntegrityGCMAES128),
+						string(network.IpsecIntegrityGCMAES192),
+						string(network.IpsecIntegrityGCMAES256),
+						string(network.IpsecIntegrityMD5),
+						string(network.IpsecIntegritySHA1),
+						string(network.IpsecIntegritySHA256),
+					}, true),
+				},
+
+				"pfs_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.PfsGroupECP256),
+						string(network.PfsGroupECP384),
+						string(network.PfsGroupNone),
+						string(network.PfsGroupPFS1),
+						string(network.PfsGroupPFS2),
+						string(network.PfsGroupPFS2048),
+						string(network.PfsGroupPFS24),
+					}, true),
+				},
+
+				"sa_datasize": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1024),
+				},
+
+				"sa_lifetime": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(300),
+				},
+			},
+		},
+	}
+}
+
 func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayConnectionsClient
 	ctx := meta.(*ArmClient).StopContext
@@ -342,6 +352,10 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
 	}
 
+	if conn.ExpressRouteGatewayBypass != nil {
+		d.Set("express_route_gateway_bypass", conn.ExpressRouteGatewayBypass)
+	}
+
 	if conn.RoutingWeight != nil {
 		d.Set("routing_weight", conn.RoutingWeight)
 	}
@@ -471,6 +485,10 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 		if props.Peer == nil || props.Peer.ID == nil {
 			return nil, fmt.Errorf("`express_route_circuit_id` must be specified when `type` is set to `ExpressRoute")
 		}
+
+		props.ExpressRouteGatewayBypass = utils.Bool(d.Get("express_route_gateway_bypass").(bool))
+	} else if d.Get("express_route_gateway_bypass").(bool) {
+		return nil, fmt.Errorf("`express_route_gateway_bypass` can only be enabled when `type` is set to `ExpressRoute`")
 	}
 
 	if props.ConnectionType == network.IPsec {
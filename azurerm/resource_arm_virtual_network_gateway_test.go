@@ -2,9 +2,11 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -235,6 +237,58 @@ func TestAccAzureRMVirtualNetworkGateway_vpnClientConfigOpenVPN(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_virtual_network_gateway.test"
+	config := testAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "vpn_client_configuration.0.aad_tenant"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.aad_audience", "41b23e61-6c1e-4545-b367-cd054e0ed4b4"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpn_client_configuration.0.aad_issuer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkGateway_customRouteAndGeneration(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_virtual_network_gateway.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_customRoute(ri, location, `["101.168.0.6/32"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "generation", "Generation2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_route.0.address_prefixes.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualNetworkGateway_customRoute(ri, location, `["101.168.0.6/32", "101.168.0.7/32"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_route.0.address_prefixes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetworkGateway_enableBgp(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	resourceName := "azurerm_virtual_network_gateway.test"
@@ -279,6 +333,219 @@ func TestAccAzureRMVirtualNetworkGateway_expressRoute(t *testing.T) {
 	})
 }
 
+func TestValidateArmVirtualNetworkGatewaySkuFeatures(t *testing.T) {
+	vpnClientConfig := func(radiusServerAddress string, protocols ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"aad_tenant":            "",
+				"radius_server_address": radiusServerAddress,
+				"vpn_client_protocols":  schema.NewSet(schema.HashString, protocols),
+			},
+		}
+	}
+	aadVpnClientConfig := func(protocols ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"aad_tenant":            "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/",
+				"radius_server_address": "",
+				"vpn_client_protocols":  schema.NewSet(schema.HashString, protocols),
+			},
+		}
+	}
+
+	cases := []struct {
+		Name             string
+		Type             string
+		VpnType          string
+		Sku              string
+		Generation       string
+		ActiveActive     bool
+		EnableBgp        bool
+		VpnClientConfigs []interface{}
+		ErrorContains    string
+	}{
+		{
+			Name:    "Basic Without Features",
+			Type:    "Vpn",
+			VpnType: "RouteBased",
+			Sku:     "Basic",
+		},
+		{
+			Name:             "Basic With SSTP",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "Basic",
+			VpnClientConfigs: vpnClientConfig("", "SSTP"),
+		},
+		{
+			Name:             "Unknown SKU Is Skipped",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			ActiveActive:     true,
+			VpnClientConfigs: vpnClientConfig("1.2.3.4", "OpenVPN"),
+		},
+		{
+			Name:             "VpnGw1 With Everything",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "VpnGw1",
+			ActiveActive:     true,
+			EnableBgp:        true,
+			VpnClientConfigs: vpnClientConfig("1.2.3.4", "SSTP", "IkeV2", "OpenVPN"),
+		},
+		{
+			Name:             "Standard With RADIUS and IkeV2",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "Standard",
+			VpnClientConfigs: vpnClientConfig("1.2.3.4", "IkeV2"),
+		},
+		{
+			Name:          "Basic With Active Active",
+			Type:          "Vpn",
+			VpnType:       "RouteBased",
+			Sku:           "Basic",
+			ActiveActive:  true,
+			ErrorContains: "`active_active` is not supported",
+		},
+		{
+			Name:          "Basic With BGP",
+			Type:          "Vpn",
+			VpnType:       "RouteBased",
+			Sku:           "basic",
+			EnableBgp:     true,
+			ErrorContains: "`enable_bgp` is not supported",
+		},
+		{
+			Name:             "ExpressRoute With VPN Client",
+			Type:             "ExpressRoute",
+			VpnType:          "RouteBased",
+			Sku:              "Standard",
+			VpnClientConfigs: vpnClientConfig(""),
+			ErrorContains:    "`type` of \"Vpn\"",
+		},
+		{
+			Name:             "Policy Based With VPN Client",
+			Type:             "Vpn",
+			VpnType:          "PolicyBased",
+			Sku:              "Basic",
+			VpnClientConfigs: vpnClientConfig(""),
+			ErrorContains:    "`vpn_type` of \"RouteBased\"",
+		},
+		{
+			Name:             "Basic With RADIUS",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "Basic",
+			VpnClientConfigs: vpnClientConfig("1.2.3.4"),
+			ErrorContains:    "RADIUS authentication is not supported",
+		},
+		{
+			Name:             "Basic With IkeV2",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "Basic",
+			VpnClientConfigs: vpnClientConfig("", "IkeV2"),
+			ErrorContains:    "\"IkeV2\" VPN Client Protocol is not supported",
+		},
+		{
+			Name:             "VpnGw1 With Azure Active Directory",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "VpnGw1",
+			VpnClientConfigs: aadVpnClientConfig("OpenVPN"),
+		},
+		{
+			Name:             "Azure Active Directory With IkeV2",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "VpnGw1",
+			VpnClientConfigs: aadVpnClientConfig("OpenVPN", "IkeV2"),
+			ErrorContains:    "Azure Active Directory authentication requires",
+		},
+		{
+			Name:             "Azure Active Directory Without Protocols",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "VpnGw1",
+			VpnClientConfigs: aadVpnClientConfig(),
+			ErrorContains:    "Azure Active Directory authentication requires",
+		},
+		{
+			Name:             "Standard With Azure Active Directory",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "Standard",
+			VpnClientConfigs: aadVpnClientConfig("OpenVPN"),
+			ErrorContains:    "\"OpenVPN\" VPN Client Protocol is not supported",
+		},
+		{
+			Name:       "VpnGw2 Generation2",
+			Type:       "Vpn",
+			VpnType:    "RouteBased",
+			Sku:        "VpnGw2",
+			Generation: "Generation2",
+		},
+		{
+			Name:       "VpnGw1 Generation1",
+			Type:       "Vpn",
+			VpnType:    "RouteBased",
+			Sku:        "VpnGw1",
+			Generation: "Generation1",
+		},
+		{
+			Name:          "VpnGw1 Generation2",
+			Type:          "Vpn",
+			VpnType:       "RouteBased",
+			Sku:           "VpnGw1",
+			Generation:    "Generation2",
+			ErrorContains: "`generation` \"Generation2\" is not supported",
+		},
+		{
+			Name:       "ExpressRoute Without Generation",
+			Type:       "ExpressRoute",
+			VpnType:    "RouteBased",
+			Sku:        "Standard",
+			Generation: "None",
+		},
+		{
+			Name:          "ExpressRoute Generation1",
+			Type:          "ExpressRoute",
+			VpnType:       "RouteBased",
+			Sku:           "Standard",
+			Generation:    "Generation1",
+			ErrorContains: "`generation` must be \"None\"",
+		},
+		{
+			Name:             "HighPerformance With OpenVPN",
+			Type:             "Vpn",
+			VpnType:          "RouteBased",
+			Sku:              "HighPerformance",
+			VpnClientConfigs: vpnClientConfig("", "OpenVPN"),
+			ErrorContains:    "\"OpenVPN\" VPN Client Protocol is not supported",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateArmVirtualNetworkGatewaySkuFeatures(v.Type, v.VpnType, v.Sku, v.Generation, v.ActiveActive, v.EnableBgp, v.VpnClientConfigs)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", v.Name, v.ErrorContains, err)
+		}
+	}
+}
+
 func testCheckAzureRMVirtualNetworkGatewayExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -671,6 +938,120 @@ resource "azurerm_virtual_network_gateway" "test" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMVirtualNetworkGateway_vpnClientConfigAzureAD(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  depends_on          = ["azurerm_public_ip.test"]
+  name                = "acctestvng-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  vpn_client_configuration {
+    address_space        = ["10.2.0.0/24"]
+    vpn_client_protocols = ["OpenVPN"]
+
+    aad_tenant   = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}/"
+    aad_audience = "41b23e61-6c1e-4545-b367-cd054e0ed4b4"
+    aad_issuer   = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualNetworkGateway_customRoute(rInt int, location string, addressPrefixes string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  depends_on          = ["azurerm_public_ip.test"]
+  name                = "acctestvng-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type       = "Vpn"
+  vpn_type   = "RouteBased"
+  sku        = "VpnGw2"
+  generation = "Generation2"
+
+  ip_configuration {
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  vpn_client_configuration {
+    address_space = ["10.2.0.0/24"]
+  }
+
+  custom_route {
+    address_prefixes = %s
+  }
+}
+`, rInt, location, rInt, rInt, rInt, addressPrefixes)
+}
+
 func testAccAzureRMVirtualNetworkGateway_sku(rInt int, location string, sku string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
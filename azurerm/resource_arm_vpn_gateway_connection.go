@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayConnectionCreateUpdate,
+		Read:   resourceArmVpnGatewayConnectionRead,
+		Update: resourceArmVpnGatewayConnectionCreateUpdate,
+		Delete: resourceArmVpnGatewayConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"vpn_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"remote_vpn_site_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.IKEv2),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IKEv1),
+					string(network.IKEv2),
+				}, false),
+			},
+
+			"routing_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+
+			"bandwidth_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"shared_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_rate_limiting": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ipsec_policy": ipsecPolicySchema(),
+
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVpnGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for VPN Gateway Connection creation.")
+
+	name := d.Get("name").(string)
+	vpnGatewayId := d.Get("vpn_gateway_id").(string)
+
+	parsedGatewayId, err := parseAzureResourceID(vpnGatewayId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := parsedGatewayId.ResourceGroup
+	gatewayName := parsedGatewayId.Path["vpnGateways"]
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, gatewayName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Connection %q (VPN Gateway %q / Resource Group %q): %s", name, gatewayName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_gateway_connection", *existing.ID)
+		}
+	}
+
+	parameters := network.VpnConnection{
+		Name: utils.String(name),
+		VpnConnectionProperties: &network.VpnConnectionProperties{
+			RemoteVpnSite: &network.SubResource{
+				ID: utils.String(d.Get("remote_vpn_site_id").(string)),
+			},
+			VpnConnectionProtocolType: network.VirtualNetworkGatewayConnectionProtocol(d.Get("protocol").(string)),
+			EnableBgp:                 utils.Bool(d.Get("enable_bgp").(bool)),
+			EnableRateLimiting:        utils.Bool(d.Get("enable_rate_limiting").(bool)),
+			EnableInternetSecurity:    utils.Bool(d.Get("enable_internet_security").(bool)),
+			IpsecPolicies:             expandArmVirtualNetworkGatewayConnectionIpsecPolicies(d.Get("ipsec_policy").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("routing_weight"); ok {
+		parameters.VpnConnectionProperties.RoutingWeight = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("bandwidth_mbps"); ok {
+		parameters.VpnConnectionProperties.ConnectionBandwidth = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("shared_key"); ok {
+		parameters.VpnConnectionProperties.SharedKey = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, gatewayName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Connection %q (VPN Gateway %q / Resource Group %q) ID", name, gatewayName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnGatewayConnectionRead(d, meta)
+}
+
+func resourceArmVpnGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["vpnGateways"]
+	name := id.Path["vpnConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (VPN Gateway %q / Resource Group %q) was not found - removing from state!", name, gatewayName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("vpn_gateway_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s", id.SubscriptionID, resourceGroup, gatewayName))
+
+	if props := resp.VpnConnectionProperties; props != nil {
+		remoteVpnSiteId := ""
+		if props.RemoteVpnSite != nil && props.RemoteVpnSite.ID != nil {
+			remoteVpnSiteId = *props.RemoteVpnSite.ID
+		}
+		d.Set("remote_vpn_site_id", remoteVpnSiteId)
+
+		d.Set("protocol", string(props.VpnConnectionProtocolType))
+		d.Set("connection_status", string(props.ConnectionStatus))
+		d.Set("enable_bgp", props.EnableBgp)
+		d.Set("enable_rate_limiting", props.EnableRateLimiting)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+
+		if props.RoutingWeight != nil {
+			d.Set("routing_weight", int(*props.RoutingWeight))
+		}
+
+		if props.ConnectionBandwidth != nil {
+			d.Set("bandwidth_mbps", int(*props.ConnectionBandwidth))
+		}
+
+		// the Shared Key isn't returned from the API - so we intentionally don't set it
+
+		if err := d.Set("ipsec_policy", flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(props.IpsecPolicies)); err != nil {
+			return fmt.Errorf("Error setting `ipsec_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVpnGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnConnectionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	gatewayName := id.Path["vpnGateways"]
+	name := id.Path["vpnConnections"]
+
+	future, err := client.Delete(ctx, resourceGroup, gatewayName, name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,832 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const azureWebApplicationFirewallPolicyResourceName = "azurerm_web_application_firewall_policy"
+
+func resourceArmWebApplicationFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Read:   resourceArmWebApplicationFirewallPolicyRead,
+		Update: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Delete: resourceArmWebApplicationFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmWebApplicationFirewallPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"custom_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "MatchRule",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallRuleTypeMatchRule),
+							}, false),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallActionAllow),
+								string(network.WebApplicationFirewallActionBlock),
+								string(network.WebApplicationFirewallActionLog),
+							}, false),
+						},
+
+						"match_conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variables": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(network.PostArgs),
+														string(network.QueryString),
+														string(network.RemoteAddr),
+														string(network.RequestBody),
+														string(network.RequestCookies),
+														string(network.RequestHeaders),
+														string(network.RequestMethod),
+														string(network.RequestURI),
+													}, false),
+												},
+
+												"selector": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.WebApplicationFirewallOperatorBeginsWith),
+											string(network.WebApplicationFirewallOperatorContains),
+											string(network.WebApplicationFirewallOperatorEndsWith),
+											string(network.WebApplicationFirewallOperatorEqual),
+											string(network.WebApplicationFirewallOperatorGeoMatch),
+											string(network.WebApplicationFirewallOperatorGreaterThan),
+											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
+											string(network.WebApplicationFirewallOperatorIPMatch),
+											string(network.WebApplicationFirewallOperatorLessThan),
+											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
+											string(network.WebApplicationFirewallOperatorRegex),
+										}, false),
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+
+									"transforms": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(network.HTMLEntityDecode),
+												string(network.Lowercase),
+												string(network.RemoveNulls),
+												string(network.Trim),
+												string(network.URLDecode),
+												string(network.URLEncode),
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"managed_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclusion": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.RequestArgNames),
+											string(network.RequestCookieNames),
+											string(network.RequestHeaderNames),
+										}, false),
+									},
+
+									"selector_match_operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
+											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
+										}, false),
+									},
+
+									"selector": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"managed_rule_set": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "OWASP",
+										ValidateFunc: validation.StringInSlice([]string{
+											"OWASP",
+										}, false),
+									},
+
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"2.2.9",
+											"3.0",
+											"3.1",
+										}, false),
+									},
+
+									"rule_group_override": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_group_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+
+												"disabled_rules": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validate.NoEmptyStrings,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"policy_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Prevention",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallModeDetection),
+								string(network.WebApplicationFirewallModePrevention),
+							}, false),
+						},
+
+						"request_body_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"max_request_body_size_in_kb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      128,
+							ValidateFunc: validation.IntBetween(8, 128),
+						},
+
+						"file_upload_limit_in_mb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 750),
+						},
+					},
+				},
+			},
+
+			"http_listener_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"path_based_rule_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmWebApplicationFirewallPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	return validateWebApplicationFirewallPolicyCustomRules(diff.Get("custom_rules").([]interface{}))
+}
+
+func resourceArmWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Web Application Firewall Policy creation")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Web Application Firewall Policy %q (Resource Group %q): %s", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError(azureWebApplicationFirewallPolicyResourceName, *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.WebApplicationFirewallPolicy{
+		Location: &location,
+		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+			CustomRules:    expandWebApplicationFirewallPolicyCustomRules(d.Get("custom_rules").([]interface{})),
+			ManagedRules:   expandWebApplicationFirewallPolicyManagedRules(d.Get("managed_rules").([]interface{})),
+			PolicySettings: expandWebApplicationFirewallPolicySettings(d.Get("policy_settings").([]interface{})),
+		},
+		Tags: expandTags(tags),
+	}
+
+	azureRMLockByName(name, azureWebApplicationFirewallPolicyResourceName)
+	defer azureRMUnlockByName(name, azureWebApplicationFirewallPolicyResourceName)
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	policy, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if policy.ID == nil {
+		return fmt.Errorf("Cannot read Web Application Firewall Policy %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*policy.ID)
+
+	return resourceArmWebApplicationFirewallPolicyRead(d, meta)
+}
+
+func resourceArmWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["ApplicationGatewayWebApplicationFirewallPolicies"]
+
+	policy, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			log.Printf("[DEBUG] Web Application Firewall Policy %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := policy.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := policy.WebApplicationFirewallPolicyPropertiesFormat; props != nil {
+		if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rules`: %+v", err)
+		}
+
+		if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRules(props.ManagedRules)); err != nil {
+			return fmt.Errorf("Error setting `managed_rules`: %+v", err)
+		}
+
+		if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicySettings(props.PolicySettings)); err != nil {
+			return fmt.Errorf("Error setting `policy_settings`: %+v", err)
+		}
+
+		if err := d.Set("http_listener_ids", flattenWebApplicationFirewallPolicySubResourceIDs(props.HTTPListeners)); err != nil {
+			return fmt.Errorf("Error setting `http_listener_ids`: %+v", err)
+		}
+
+		if err := d.Set("path_based_rule_ids", flattenWebApplicationFirewallPolicySubResourceIDs(props.PathBasedRules)); err != nil {
+			return fmt.Errorf("Error setting `path_based_rule_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, policy.Tags)
+
+	return nil
+}
+
+func resourceArmWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["ApplicationGatewayWebApplicationFirewallPolicies"]
+
+	azureRMLockByName(name, azureWebApplicationFirewallPolicyResourceName)
+	defer azureRMUnlockByName(name, azureWebApplicationFirewallPolicyResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Web Application Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// validateWebApplicationFirewallPolicyCustomRules ensures the names and priorities of the Custom Rules are unique,
+// since the API otherwise only returns a generic error once the Policy's being provisioned
+func validateWebApplicationFirewallPolicyCustomRules(rules []interface{}) error {
+	names := make(map[string]struct{})
+	priorities := make(map[int]string)
+
+	for _, ruleRaw := range rules {
+		if ruleRaw == nil {
+			continue
+		}
+		rule := ruleRaw.(map[string]interface{})
+
+		name := rule["name"].(string)
+		if name != "" {
+			key := strings.ToLower(name)
+			if _, exists := names[key]; exists {
+				return fmt.Errorf("The name %q is used by more than one `custom_rules` block - names must be unique", name)
+			}
+			names[key] = struct{}{}
+		}
+
+		priority := rule["priority"].(int)
+		if priority == 0 {
+			continue
+		}
+
+		if existing, exists := priorities[priority]; exists {
+			return fmt.Errorf("The Custom Rules %q and %q both have the priority %d - priorities must be unique", existing, name, priority)
+		}
+		priorities[priority] = name
+	}
+
+	return nil
+}
+
+func expandWebApplicationFirewallPolicyCustomRules(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
+	results := make([]network.WebApplicationFirewallCustomRule, 0)
+
+	for _, ruleRaw := range input {
+		if ruleRaw == nil {
+			continue
+		}
+		rule := ruleRaw.(map[string]interface{})
+
+		results = append(results, network.WebApplicationFirewallCustomRule{
+			Name:            utils.String(rule["name"].(string)),
+			Priority:        utils.Int32(int32(rule["priority"].(int))),
+			RuleType:        network.WebApplicationFirewallRuleType(rule["rule_type"].(string)),
+			Action:          network.WebApplicationFirewallAction(rule["action"].(string)),
+			MatchConditions: expandWebApplicationFirewallPolicyMatchConditions(rule["match_conditions"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandWebApplicationFirewallPolicyMatchConditions(input []interface{}) *[]network.MatchCondition {
+	results := make([]network.MatchCondition, 0)
+
+	for _, conditionRaw := range input {
+		if conditionRaw == nil {
+			continue
+		}
+		condition := conditionRaw.(map[string]interface{})
+
+		variables := make([]network.MatchVariable, 0)
+		for _, variableRaw := range condition["match_variables"].([]interface{}) {
+			if variableRaw == nil {
+				continue
+			}
+			variable := variableRaw.(map[string]interface{})
+
+			matchVariable := network.MatchVariable{
+				VariableName: network.WebApplicationFirewallMatchVariable(variable["variable_name"].(string)),
+			}
+			if selector := variable["selector"].(string); selector != "" {
+				matchVariable.Selector = utils.String(selector)
+			}
+			variables = append(variables, matchVariable)
+		}
+
+		values := make([]string, 0)
+		for _, value := range condition["match_values"].([]interface{}) {
+			values = append(values, value.(string))
+		}
+
+		transforms := make([]network.WebApplicationFirewallTransform, 0)
+		for _, transform := range condition["transforms"].(*schema.Set).List() {
+			transforms = append(transforms, network.WebApplicationFirewallTransform(transform.(string)))
+		}
+
+		results = append(results, network.MatchCondition{
+			MatchVariables:   &variables,
+			Operator:         network.WebApplicationFirewallOperator(condition["operator"].(string)),
+			NegationConditon: utils.Bool(condition["negation_condition"].(bool)),
+			MatchValues:      &values,
+			Transforms:       &transforms,
+		})
+	}
+
+	return &results
+}
+
+func expandWebApplicationFirewallPolicyManagedRules(input []interface{}) *network.ManagedRulesDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	exclusions := make([]network.OwaspCrsExclusionEntry, 0)
+	for _, exclusionRaw := range v["exclusion"].([]interface{}) {
+		if exclusionRaw == nil {
+			continue
+		}
+		exclusion := exclusionRaw.(map[string]interface{})
+
+		exclusions = append(exclusions, network.OwaspCrsExclusionEntry{
+			MatchVariable:         network.OwaspCrsExclusionEntryMatchVariable(exclusion["match_variable"].(string)),
+			SelectorMatchOperator: network.OwaspCrsExclusionEntrySelectorMatchOperator(exclusion["selector_match_operator"].(string)),
+			Selector:              utils.String(exclusion["selector"].(string)),
+		})
+	}
+
+	ruleSets := make([]network.ManagedRuleSet, 0)
+	for _, ruleSetRaw := range v["managed_rule_set"].([]interface{}) {
+		if ruleSetRaw == nil {
+			continue
+		}
+		ruleSet := ruleSetRaw.(map[string]interface{})
+
+		overrides := make([]network.ManagedRuleGroupOverride, 0)
+		for _, overrideRaw := range ruleSet["rule_group_override"].([]interface{}) {
+			if overrideRaw == nil {
+				continue
+			}
+			override := overrideRaw.(map[string]interface{})
+
+			rules := make([]network.ManagedRuleOverride, 0)
+			for _, ruleId := range override["disabled_rules"].([]interface{}) {
+				rules = append(rules, network.ManagedRuleOverride{
+					RuleID: utils.String(ruleId.(string)),
+					State:  network.ManagedRuleEnabledStateDisabled,
+				})
+			}
+
+			overrides = append(overrides, network.ManagedRuleGroupOverride{
+				RuleGroupName: utils.String(override["rule_group_name"].(string)),
+				Rules:         &rules,
+			})
+		}
+
+		ruleSets = append(ruleSets, network.ManagedRuleSet{
+			RuleSetType:        utils.String(ruleSet["type"].(string)),
+			RuleSetVersion:     utils.String(ruleSet["version"].(string)),
+			RuleGroupOverrides: &overrides,
+		})
+	}
+
+	return &network.ManagedRulesDefinition{
+		Exclusions:      &exclusions,
+		ManagedRuleSets: &ruleSets,
+	}
+}
+
+func expandWebApplicationFirewallPolicySettings(input []interface{}) *network.PolicySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	state := network.WebApplicationFirewallEnabledStateDisabled
+	if v["enabled"].(bool) {
+		state = network.WebApplicationFirewallEnabledStateEnabled
+	}
+
+	return &network.PolicySettings{
+		State:                  state,
+		Mode:                   network.WebApplicationFirewallMode(v["mode"].(string)),
+		RequestBodyCheck:       utils.Bool(v["request_body_check"].(bool)),
+		MaxRequestBodySizeInKb: utils.Int32(int32(v["max_request_body_size_in_kb"].(int))),
+		FileUploadLimitInMb:    utils.Int32(int32(v["file_upload_limit_in_mb"].(int))),
+	}
+}
+
+func flattenWebApplicationFirewallPolicyCustomRules(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		output := make(map[string]interface{})
+
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Priority != nil {
+			output["priority"] = int(*rule.Priority)
+		}
+		output["rule_type"] = string(rule.RuleType)
+		output["action"] = string(rule.Action)
+		output["match_conditions"] = flattenWebApplicationFirewallPolicyMatchConditions(rule.MatchConditions)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenWebApplicationFirewallPolicyMatchConditions(input *[]network.MatchCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, condition := range *input {
+		output := make(map[string]interface{})
+
+		variables := make([]interface{}, 0)
+		if condition.MatchVariables != nil {
+			for _, variable := range *condition.MatchVariables {
+				v := make(map[string]interface{})
+				v["variable_name"] = string(variable.VariableName)
+				if variable.Selector != nil {
+					v["selector"] = *variable.Selector
+				}
+				variables = append(variables, v)
+			}
+		}
+		output["match_variables"] = variables
+
+		output["operator"] = string(condition.Operator)
+		if condition.NegationConditon != nil {
+			output["negation_condition"] = *condition.NegationConditon
+		}
+
+		values := make([]interface{}, 0)
+		if condition.MatchValues != nil {
+			for _, value := range *condition.MatchValues {
+				values = append(values, value)
+			}
+		}
+		output["match_values"] = values
+
+		transforms := make([]interface{}, 0)
+		if condition.Transforms != nil {
+			for _, transform := range *condition.Transforms {
+				transforms = append(transforms, string(transform))
+			}
+		}
+		output["transforms"] = schema.NewSet(schema.HashString, transforms)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenWebApplicationFirewallPolicyManagedRules(input *network.ManagedRulesDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	exclusions := make([]interface{}, 0)
+	if input.Exclusions != nil {
+		for _, exclusion := range *input.Exclusions {
+			output := make(map[string]interface{})
+			output["match_variable"] = string(exclusion.MatchVariable)
+			output["selector_match_operator"] = string(exclusion.SelectorMatchOperator)
+			if exclusion.Selector != nil {
+				output["selector"] = *exclusion.Selector
+			}
+			exclusions = append(exclusions, output)
+		}
+	}
+
+	ruleSets := make([]interface{}, 0)
+	if input.ManagedRuleSets != nil {
+		for _, ruleSet := range *input.ManagedRuleSets {
+			output := make(map[string]interface{})
+			if ruleSet.RuleSetType != nil {
+				output["type"] = *ruleSet.RuleSetType
+			}
+			if ruleSet.RuleSetVersion != nil {
+				output["version"] = *ruleSet.RuleSetVersion
+			}
+
+			overrides := make([]interface{}, 0)
+			if ruleSet.RuleGroupOverrides != nil {
+				for _, override := range *ruleSet.RuleGroupOverrides {
+					o := make(map[string]interface{})
+					if override.RuleGroupName != nil {
+						o["rule_group_name"] = *override.RuleGroupName
+					}
+
+					disabledRules := make([]interface{}, 0)
+					if override.Rules != nil {
+						for _, rule := range *override.Rules {
+							if rule.RuleID == nil {
+								continue
+							}
+							if rule.State != "" && rule.State != network.ManagedRuleEnabledStateDisabled {
+								continue
+							}
+							disabledRules = append(disabledRules, *rule.RuleID)
+						}
+					}
+					o["disabled_rules"] = disabledRules
+
+					overrides = append(overrides, o)
+				}
+			}
+			output["rule_group_override"] = overrides
+
+			ruleSets = append(ruleSets, output)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"exclusion":        exclusions,
+			"managed_rule_set": ruleSets,
+		},
+	}
+}
+
+func flattenWebApplicationFirewallPolicySettings(input *network.PolicySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	output["enabled"] = input.State == network.WebApplicationFirewallEnabledStateEnabled
+	output["mode"] = string(input.Mode)
+	if input.RequestBodyCheck != nil {
+		output["request_body_check"] = *input.RequestBodyCheck
+	}
+	if input.MaxRequestBodySizeInKb != nil {
+		output["max_request_body_size_in_kb"] = int(*input.MaxRequestBodySizeInKb)
+	}
+	if input.FileUploadLimitInMb != nil {
+		output["file_upload_limit_in_mb"] = int(*input.FileUploadLimitInMb)
+	}
+
+	return []interface{}{output}
+}
+
+func flattenWebApplicationFirewallPolicySubResourceIDs(input *[]network.SubResource) []string {
+	ids := make([]string, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+
+	return ids
+}
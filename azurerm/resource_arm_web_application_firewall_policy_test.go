@@ -0,0 +1,363 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMWebApplicationFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.managed_rule_set.0.type", "OWASP"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.managed_rule_set.0.version", "3.1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Prevention"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMWebApplicationFirewallPolicy_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_web_application_firewall_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMWebApplicationFirewallPolicy_update(t *testing.T) {
+	resourceName := "azurerm_web_application_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMWebApplicationFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.0.name", "Rule1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.0.match_conditions.0.match_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.1.match_conditions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.exclusion.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.managed_rule_set.0.rule_group_override.0.disabled_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.mode", "Detection"),
+					resource.TestCheckResourceAttr(resourceName, "policy_settings.0.file_upload_limit_in_mb", "200"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMWebApplicationFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rules.0.exclusion.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateWebApplicationFirewallPolicyCustomRules(t *testing.T) {
+	rule := func(name string, priority int) interface{} {
+		return map[string]interface{}{
+			"name":     name,
+			"priority": priority,
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Rules         []interface{}
+		ErrorContains string
+	}{
+		{
+			Name:  "No Rules",
+			Rules: []interface{}{},
+		},
+		{
+			Name:  "Unique Rules",
+			Rules: []interface{}{rule("rule1", 1), rule("rule2", 2)},
+		},
+		{
+			Name:  "Unknown Values Are Skipped",
+			Rules: []interface{}{rule("", 1), rule("", 2), rule("rule3", 0), rule("rule4", 0)},
+		},
+		{
+			Name:          "Duplicate Names",
+			Rules:         []interface{}{rule("rule1", 1), rule("RULE1", 2)},
+			ErrorContains: "used by more than one `custom_rules` block",
+		},
+		{
+			Name:          "Duplicate Priority",
+			Rules:         []interface{}{rule("rule1", 5), rule("rule2", 5)},
+			ErrorContains: "both have the priority 5",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateWebApplicationFirewallPolicyCustomRules(v.Rules)
+		if v.ErrorContains == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if !strings.Contains(err.Error(), v.ErrorContains) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", v.Name, v.ErrorContains, err)
+		}
+	}
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Web Application Firewall Policy: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).wafPolicyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Web Application Firewall Policy %q (Resource Group: %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on wafPolicyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMWebApplicationFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).wafPolicyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_web_application_firewall_policy" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Web Application Firewall Policy still exists:\n%#v", resp.WebApplicationFirewallPolicyPropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  managed_rules {
+    managed_rule_set {
+      version = "3.1"
+    }
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMWebApplicationFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy" "import" {
+  name                = "${azurerm_web_application_firewall_policy.test.name}"
+  resource_group_name = "${azurerm_web_application_firewall_policy.test.resource_group_name}"
+  location            = "${azurerm_web_application_firewall_policy.test.location}"
+
+  managed_rules {
+    managed_rule_set {
+      version = "3.1"
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMWebApplicationFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  custom_rules {
+    name     = "Rule1"
+    priority = 1
+    action   = "Block"
+
+    match_conditions {
+      match_variables {
+        variable_name = "RemoteAddr"
+      }
+
+      operator     = "IPMatch"
+      match_values = ["192.168.1.0/24", "10.0.0.0/24"]
+    }
+  }
+
+  custom_rules {
+    name     = "Rule2"
+    priority = 2
+    action   = "Block"
+
+    match_conditions {
+      match_variables {
+        variable_name = "RemoteAddr"
+      }
+
+      operator     = "IPMatch"
+      match_values = ["192.168.1.0/24"]
+    }
+
+    match_conditions {
+      match_variables {
+        variable_name = "RequestHeaders"
+        selector      = "UserAgent"
+      }
+
+      operator     = "Contains"
+      match_values = ["Windows"]
+      transforms   = ["Lowercase"]
+    }
+  }
+
+  managed_rules {
+    exclusion {
+      match_variable          = "RequestHeaderNames"
+      selector_match_operator = "Equals"
+      selector                = "x-company-secret-header"
+    }
+
+    exclusion {
+      match_variable          = "RequestCookieNames"
+      selector_match_operator = "EndsWith"
+      selector                = "too-tasty"
+    }
+
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.1"
+
+      rule_group_override {
+        rule_group_name = "REQUEST-920-PROTOCOL-ENFORCEMENT"
+        disabled_rules  = ["920300", "920440"]
+      }
+    }
+  }
+
+  policy_settings {
+    enabled                     = true
+    mode                        = "Detection"
+    request_body_check          = true
+    max_request_body_size_in_kb = 128
+    file_upload_limit_in_mb     = 200
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
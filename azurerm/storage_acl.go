@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// storageACLSchema returns the schema for the Stored Access Policies of a Share, Queue or Table, where
+// `permissions` are the characters which are valid for the service (e.g. `rwdl` for a Share)
+func storageACLSchema(permissions string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+
+				"access_policy": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"permissions": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringMatch(
+									regexp.MustCompile(fmt.Sprintf("^[%s]+$", permissions)),
+									fmt.Sprintf("`permissions` can only contain the characters %q", permissions),
+								),
+							},
+
+							"start": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.RFC3339Time,
+							},
+
+							"expiry": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.RFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandStorageACLs(input []interface{}) ([]mainStorage.SignedIdentifier, error) {
+	results := make([]mainStorage.SignedIdentifier, 0)
+
+	for _, v := range input {
+		acl := v.(map[string]interface{})
+		id := acl["id"].(string)
+
+		policies := acl["access_policy"].([]interface{})
+		if len(policies) == 0 || policies[0] == nil {
+			return nil, fmt.Errorf("An `access_policy` must be specified for the ACL %q", id)
+		}
+		policy := policies[0].(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, policy["start"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start` for the ACL %q: %+v", id, err)
+		}
+
+		expiry, err := time.Parse(time.RFC3339, policy["expiry"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `expiry` for the ACL %q: %+v", id, err)
+		}
+
+		results = append(results, mainStorage.SignedIdentifier{
+			ID: id,
+			AccessPolicy: mainStorage.AccessPolicyDetailsXML{
+				StartTime:  start.UTC(),
+				ExpiryTime: expiry.UTC(),
+				Permission: policy["permissions"].(string),
+			},
+		})
+	}
+
+	return results, nil
+}
+
+func flattenStorageACLs(input []mainStorage.SignedIdentifier) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		results = append(results, map[string]interface{}{
+			"id": v.ID,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"permissions": v.AccessPolicy.Permission,
+					"start":       v.AccessPolicy.StartTime.UTC().Format(time.RFC3339),
+					"expiry":      v.AccessPolicy.ExpiryTime.UTC().Format(time.RFC3339),
+				},
+			},
+		})
+	}
+
+	return results
+}
+
+// the Storage SDK represents the permissions of Queue and Table Access Policies as booleans, which are mapped to and
+// from the permission characters (in the order the service returns them) below
+
+func expandStorageQueueACLs(input []interface{}) ([]mainStorage.QueueAccessPolicy, error) {
+	signedIdentifiers, err := expandStorageACLs(input)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]mainStorage.QueueAccessPolicy, 0)
+	for _, v := range signedIdentifiers {
+		permissions := v.AccessPolicy.Permission
+		results = append(results, mainStorage.QueueAccessPolicy{
+			ID:         v.ID,
+			StartTime:  v.AccessPolicy.StartTime,
+			ExpiryTime: v.AccessPolicy.ExpiryTime,
+			CanRead:    strings.Contains(permissions, "r"),
+			CanAdd:     strings.Contains(permissions, "a"),
+			CanUpdate:  strings.Contains(permissions, "u"),
+			CanProcess: strings.Contains(permissions, "p"),
+		})
+	}
+
+	return results, nil
+}
+
+func flattenStorageQueueACLs(input []mainStorage.QueueAccessPolicy) []interface{} {
+	signedIdentifiers := make([]mainStorage.SignedIdentifier, 0)
+	for _, v := range input {
+		signedIdentifiers = append(signedIdentifiers, mainStorage.SignedIdentifier{
+			ID: v.ID,
+			AccessPolicy: mainStorage.AccessPolicyDetailsXML{
+				StartTime:  v.StartTime,
+				ExpiryTime: v.ExpiryTime,
+				Permission: flattenStorageACLPermissions(map[string]bool{
+					"r": v.CanRead,
+					"a": v.CanAdd,
+					"u": v.CanUpdate,
+					"p": v.CanProcess,
+				}, "raup"),
+			},
+		})
+	}
+
+	return flattenStorageACLs(signedIdentifiers)
+}
+
+func expandStorageTableACLs(input []interface{}) ([]mainStorage.TableAccessPolicy, error) {
+	signedIdentifiers, err := expandStorageACLs(input)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]mainStorage.TableAccessPolicy, 0)
+	for _, v := range signedIdentifiers {
+		permissions := v.AccessPolicy.Permission
+		results = append(results, mainStorage.TableAccessPolicy{
+			ID:         v.ID,
+			StartTime:  v.AccessPolicy.StartTime,
+			ExpiryTime: v.AccessPolicy.ExpiryTime,
+			CanRead:    strings.Contains(permissions, "r"),
+			CanAppend:  strings.Contains(permissions, "a"),
+			CanUpdate:  strings.Contains(permissions, "u"),
+			CanDelete:  strings.Contains(permissions, "d"),
+		})
+	}
+
+	return results, nil
+}
+
+func flattenStorageTableACLs(input []mainStorage.TableAccessPolicy) []interface{} {
+	signedIdentifiers := make([]mainStorage.SignedIdentifier, 0)
+	for _, v := range input {
+		signedIdentifiers = append(signedIdentifiers, mainStorage.SignedIdentifier{
+			ID: v.ID,
+			AccessPolicy: mainStorage.AccessPolicyDetailsXML{
+				StartTime:  v.StartTime,
+				ExpiryTime: v.ExpiryTime,
+				Permission: flattenStorageACLPermissions(map[string]bool{
+					"r": v.CanRead,
+					"a": v.CanAppend,
+					"u": v.CanUpdate,
+					"d": v.CanDelete,
+				}, "raud"),
+			},
+		})
+	}
+
+	return flattenStorageACLs(signedIdentifiers)
+}
+
+func flattenStorageACLPermissions(input map[string]bool, order string) string {
+	permissions := ""
+	for _, c := range order {
+		if input[string(c)] {
+			permissions += string(c)
+		}
+	}
+	return permissions
+}
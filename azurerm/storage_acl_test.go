@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStorageACLsRoundTrip(t *testing.T) {
+	acl := func(id, permissions, start, expiry string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"permissions": permissions,
+					"start":       start,
+					"expiry":      expiry,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:     "Empty",
+			Input:    []interface{}{},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "UTC",
+			Input: []interface{}{
+				acl("readonly", "rl", "2019-07-02T09:38:21Z", "2019-07-02T10:38:21Z"),
+			},
+			Expected: []interface{}{
+				acl("readonly", "rl", "2019-07-02T09:38:21Z", "2019-07-02T10:38:21Z"),
+			},
+		},
+		{
+			Name: "Offset Normalised To UTC",
+			Input: []interface{}{
+				acl("readonly", "rl", "2019-07-02T10:38:21+01:00", "2019-07-02T11:38:21+01:00"),
+				acl("readwrite", "rwdl", "2019-07-02T09:38:21Z", "2019-07-03T09:38:21Z"),
+			},
+			Expected: []interface{}{
+				acl("readonly", "rl", "2019-07-02T09:38:21Z", "2019-07-02T10:38:21Z"),
+				acl("readwrite", "rwdl", "2019-07-02T09:38:21Z", "2019-07-03T09:38:21Z"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		expanded, err := expandStorageACLs(tc.Input)
+		if err != nil {
+			t.Fatalf("Error expanding ACLs: %+v", err)
+		}
+
+		actual := flattenStorageACLs(expanded)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestExpandStorageACLsMissingAccessPolicy(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"id":            "readonly",
+			"access_policy": []interface{}{},
+		},
+	}
+
+	if _, err := expandStorageACLs(input); err == nil {
+		t.Fatalf("Expected an error when the `access_policy` is missing but didn't get one")
+	}
+}
+
+func TestStorageQueueAndTableACLsRoundTrip(t *testing.T) {
+	acl := func(id, permissions string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"permissions": permissions,
+					"start":       "2019-07-02T09:38:21Z",
+					"expiry":      "2019-07-02T10:38:21Z",
+				},
+			},
+		}
+	}
+
+	queueInput := []interface{}{acl("read", "r"), acl("all", "raup")}
+	queueACLs, err := expandStorageQueueACLs(queueInput)
+	if err != nil {
+		t.Fatalf("Error expanding Queue ACLs: %+v", err)
+	}
+	if !queueACLs[1].CanRead || !queueACLs[1].CanAdd || !queueACLs[1].CanUpdate || !queueACLs[1].CanProcess {
+		t.Fatalf("Expected all Queue permissions to be granted but got %+v", queueACLs[1])
+	}
+	if actual := flattenStorageQueueACLs(queueACLs); !reflect.DeepEqual(actual, queueInput) {
+		t.Fatalf("Expected %+v but got %+v", queueInput, actual)
+	}
+
+	tableInput := []interface{}{acl("readdelete", "rd"), acl("all", "raud")}
+	tableACLs, err := expandStorageTableACLs(tableInput)
+	if err != nil {
+		t.Fatalf("Error expanding Table ACLs: %+v", err)
+	}
+	if !tableACLs[0].CanRead || tableACLs[0].CanAppend || tableACLs[0].CanUpdate || !tableACLs[0].CanDelete {
+		t.Fatalf("Expected only the read and delete Table permissions to be granted but got %+v", tableACLs[0])
+	}
+	if actual := flattenStorageTableACLs(tableACLs); !reflect.DeepEqual(actual, tableInput) {
+		t.Fatalf("Expected %+v but got %+v", tableInput, actual)
+	}
+}
@@ -0,0 +1,54 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// the Azure Active Directory audience used for Storage data-plane operations, which is the same in every cloud
+	storageAzureADResource = "https://storage.azure.com/"
+
+	// Bearer Tokens are only accepted from this version of the Storage API onwards
+	storageAzureADAPIVersion = "2017-11-09"
+)
+
+// the Storage SDK requires an Account Key to build a client, however when authenticating using Azure Active Directory
+// this is never sent since the Shared Key signature is replaced with a Bearer Token by the storageBearerTokenSender
+var storageAzureADPlaceholderKey = base64.StdEncoding.EncodeToString([]byte("azuread"))
+
+// storageBearerTokenSender authorizes requests made by the Storage data-plane clients using an Azure Active Directory
+// token for the Storage audience, rather than the Shared Key signature the clients would otherwise use
+type storageBearerTokenSender struct {
+	authorizer autorest.Authorizer
+	sender     mainStorage.Sender
+}
+
+func (s storageBearerTokenSender) Send(c *mainStorage.Client, req *http.Request) (*http.Response, error) {
+	req.Header.Del("Authorization")
+
+	req, err := autorest.Prepare(req, s.authorizer.WithAuthorization())
+	if err != nil {
+		return nil, fmt.Errorf("Error obtaining an Azure Active Directory token for Storage: %+v", err)
+	}
+
+	return s.sender.Send(c, req)
+}
+
+func newStorageAzureADClient(accountName, endpointSuffix string, authorizer autorest.Authorizer) (*mainStorage.Client, error) {
+	client, err := mainStorage.NewClient(accountName, storageAzureADPlaceholderKey, endpointSuffix, storageAzureADAPIVersion, true)
+	if err != nil {
+		return nil, err
+	}
+
+	client.Sender = storageBearerTokenSender{
+		authorizer: authorizer,
+		sender:     client.Sender,
+	}
+
+	return &client, nil
+}
@@ -0,0 +1,90 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testStorageStaticAuthorizer struct {
+	token string
+}
+
+func (a testStorageStaticAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return autorest.WithBearerAuthorization(a.token)
+}
+
+type testStorageRecordingSender struct {
+	requests []*http.Request
+}
+
+func (s *testStorageRecordingSender) Send(c *mainStorage.Client, req *http.Request) (*http.Response, error) {
+	s.requests = append(s.requests, req)
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestStorageBearerTokenSender(t *testing.T) {
+	recorder := &testStorageRecordingSender{}
+	sender := storageBearerTokenSender{
+		authorizer: testStorageStaticAuthorizer{token: "abc123"},
+		sender:     recorder,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/container?restype=container", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+	// the Storage SDK sets the Shared Key signature directly on the map
+	req.Header["Authorization"] = []string{"SharedKey example:signature"}
+
+	if _, err := sender.Send(nil, req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(recorder.requests) != 1 {
+		t.Fatalf("Expected 1 request to be sent but got %d", len(recorder.requests))
+	}
+
+	actual := recorder.requests[0].Header["Authorization"]
+	if len(actual) != 1 || actual[0] != "Bearer abc123" {
+		t.Fatalf("Expected the Authorization header to be `Bearer abc123` but got %+v", actual)
+	}
+}
+
+func TestNewStorageAzureADClient(t *testing.T) {
+	client, err := newStorageAzureADClient("example", "core.windows.net", testStorageStaticAuthorizer{token: "abc123"})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if _, ok := client.Sender.(storageBearerTokenSender); !ok {
+		t.Fatalf("Expected the client to use a storageBearerTokenSender but got %T", client.Sender)
+	}
+
+	if _, err := newStorageAzureADClient("Invalid_Name", "core.windows.net", testStorageStaticAuthorizer{token: "abc123"}); err == nil {
+		t.Fatalf("Expected an error for an invalid Storage Account name but didn't get one")
+	}
+}
+
+func TestGetStorageClientForStorageAccount_azureADUnsupportedService(t *testing.T) {
+	client := &ArmClient{
+		storageUseAzureAD: true,
+	}
+
+	// the Account Key mustn't be retrieved unless the fallback's been enabled
+	_, _, err := client.getFileServiceClientForStorageAccount(context.Background(), "group1", "account1")
+	if err == nil {
+		t.Fatalf("Expected an error for the File service but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "storage_allow_shared_key_fallback") {
+		t.Fatalf("Expected the error to mention `storage_allow_shared_key_fallback` but got: %+v", err)
+	}
+
+	if _, _, err := client.getTableServiceClientForStorageAccount(context.Background(), "group1", "account1"); err == nil {
+		t.Fatalf("Expected an error for the Table service but didn't get one")
+	}
+}
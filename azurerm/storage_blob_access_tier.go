@@ -0,0 +1,138 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the version of the Storage SDK used by the provider predates Blob Access Tiers, so until that's upgraded this is a
+// minimal data-plane client for them
+
+const storageBlobAccessTierAPIVersion = "2018-03-28"
+
+type storageBlobAccessTierClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+type storageBlobAccessTier struct {
+	autorest.Response
+	AccessTier string
+	// AccessTierInferred is true when the Access Tier hasn't been set on the Blob and is inherited from the Account
+	AccessTierInferred bool
+	// ArchiveStatus is set whilst a Blob is being rehydrated from the Archive tier, e.g. `rehydrate-pending-to-hot`
+	ArchiveStatus string
+}
+
+// targetAccessTier returns the Access Tier the Blob will have once any pending rehydration has completed - since
+// the Access Tier remains `Archive` until then
+func (tier storageBlobAccessTier) targetAccessTier() string {
+	switch strings.ToLower(tier.ArchiveStatus) {
+	case "rehydrate-pending-to-cool":
+		return "Cool"
+	case "rehydrate-pending-to-hot":
+		return "Hot"
+	}
+
+	return tier.AccessTier
+}
+
+func newStorageBlobAccessTierClient(accountName, endpointSuffix string) storageBlobAccessTierClient {
+	return storageBlobAccessTierClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: fmt.Sprintf("https://%s.blob.%s", accountName, endpointSuffix),
+	}
+}
+
+func (client storageBlobAccessTierClient) preparer(ctx context.Context, containerName, blobName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	// Blob names can contain `/` which need to be retained as path separators
+	segments := strings.Split(blobName, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	blobURL := fmt.Sprintf("%s/%s/%s", client.BaseURI, url.PathEscape(containerName), strings.Join(segments, "/"))
+
+	// the headers need to be set prior to the request being signed
+	decorators = append([]autorest.PrepareDecorator{autorest.WithBaseURL(blobURL)}, decorators...)
+	decorators = append(decorators,
+		autorest.WithHeader("x-ms-date", time.Now().UTC().Format(http.TimeFormat)),
+		autorest.WithHeader("x-ms-version", storageBlobAccessTierAPIVersion),
+		client.WithAuthorization())
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetAccessTier retrieves the Access Tier of the Blob from its properties
+func (client storageBlobAccessTierClient) GetAccessTier(ctx context.Context, containerName, blobName string) (result storageBlobAccessTier, err error) {
+	req, err := client.preparer(ctx, containerName, blobName, autorest.AsHead())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "GetAccessTier", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "GetAccessTier", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "GetAccessTier", resp, "Failure responding to request")
+		return
+	}
+
+	result.AccessTier = resp.Header.Get("x-ms-access-tier")
+	result.AccessTierInferred = strings.EqualFold(resp.Header.Get("x-ms-access-tier-inferred"), "true")
+	result.ArchiveStatus = resp.Header.Get("x-ms-archive-status")
+	return
+}
+
+// SetAccessTier sets the Access Tier of a Block Blob
+func (client storageBlobAccessTierClient) SetAccessTier(ctx context.Context, containerName, blobName, accessTier string) (result autorest.Response, err error) {
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "tier"),
+	}
+
+	req, err := client.preparer(ctx, containerName, blobName,
+		autorest.AsPut(),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-access-tier", accessTier))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "SetAccessTier", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "SetAccessTier", resp, "Failure sending request")
+		return
+	}
+
+	// rehydrating a Blob from the Archive tier is asynchronous and returns a 202
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobAccessTierClient", "SetAccessTier", resp, "Failure responding to request")
+	}
+
+	return
+}
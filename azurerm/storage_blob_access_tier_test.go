@@ -0,0 +1,93 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestStorageBlobAccessTierGetAccessTier(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Headers        map[string]string
+		ExpectedTier   string
+		ExpectedTarget string
+	}{
+		{
+			Name: "Inferred",
+			Headers: map[string]string{
+				"x-ms-access-tier":          "Hot",
+				"x-ms-access-tier-inferred": "true",
+			},
+			ExpectedTier:   "Hot",
+			ExpectedTarget: "Hot",
+		},
+		{
+			Name: "Archived",
+			Headers: map[string]string{
+				"x-ms-access-tier": "Archive",
+			},
+			ExpectedTier:   "Archive",
+			ExpectedTarget: "Archive",
+		},
+		{
+			Name: "Rehydrating to Hot",
+			Headers: map[string]string{
+				"x-ms-access-tier":    "Archive",
+				"x-ms-archive-status": "rehydrate-pending-to-hot",
+			},
+			ExpectedTier:   "Archive",
+			ExpectedTarget: "Hot",
+		},
+		{
+			Name: "Rehydrating to Cool",
+			Headers: map[string]string{
+				"x-ms-access-tier":    "Archive",
+				"x-ms-archive-status": "rehydrate-pending-to-cool",
+			},
+			ExpectedTier:   "Archive",
+			ExpectedTarget: "Cool",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		client := newStorageBlobAccessTierClient("acctestaccount", "core.windows.net")
+		client.Sender = autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodHead {
+				t.Fatalf("Expected a HEAD request but got %q", req.Method)
+			}
+			if expected := "https://acctestaccount.blob.core.windows.net/container/folder/blob%201"; req.URL.String() != expected {
+				t.Fatalf("Expected the URL to be %q but got %q", expected, req.URL.String())
+			}
+
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+				Request:    req,
+			}
+			for k, v := range tc.Headers {
+				resp.Header.Set(k, v)
+			}
+			return resp, nil
+		})
+
+		tier, err := client.GetAccessTier(context.Background(), "container", "folder/blob 1")
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if tier.AccessTier != tc.ExpectedTier {
+			t.Fatalf("Expected the Access Tier to be %q but got %q", tc.ExpectedTier, tier.AccessTier)
+		}
+		if actual := tier.targetAccessTier(); actual != tc.ExpectedTarget {
+			t.Fatalf("Expected the target Access Tier to be %q but got %q", tc.ExpectedTarget, actual)
+		}
+	}
+}
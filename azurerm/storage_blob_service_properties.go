@@ -0,0 +1,130 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the version of the Storage SDK used by the provider predates Soft Delete, the Default Service Version and Static
+// Websites in the Blob Service Properties, so until that's upgraded this is a minimal data-plane client for them
+
+const storageBlobServicePropertiesAPIVersion = "2018-03-28"
+
+type storageBlobServicePropertiesClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+type storageBlobServiceProperties struct {
+	autorest.Response     `xml:"-"`
+	XMLName               xml.Name                      `xml:"StorageServiceProperties"`
+	Cors                  *mainStorage.Cors             `xml:"Cors,omitempty"`
+	DefaultServiceVersion *string                       `xml:"DefaultServiceVersion,omitempty"`
+	DeleteRetentionPolicy *storageDeleteRetentionPolicy `xml:"DeleteRetentionPolicy,omitempty"`
+	StaticWebsite         *storageStaticWebsite         `xml:"StaticWebsite,omitempty"`
+}
+
+type storageDeleteRetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    *int `xml:"Days,omitempty"`
+}
+
+type storageStaticWebsite struct {
+	Enabled              bool    `xml:"Enabled"`
+	IndexDocument        *string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path *string `xml:"ErrorDocument404Path,omitempty"`
+}
+
+func newStorageBlobServicePropertiesClient(accountName, endpointSuffix string) storageBlobServicePropertiesClient {
+	return storageBlobServicePropertiesClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: fmt.Sprintf("https://%s.blob.%s", accountName, endpointSuffix),
+	}
+}
+
+func (client storageBlobServicePropertiesClient) preparer(ctx context.Context, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"comp":    autorest.Encode("query", "properties"),
+		"restype": autorest.Encode("query", "service"),
+	}
+
+	// the headers need to be set prior to the request being signed
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-date", time.Now().UTC().Format(http.TimeFormat)),
+		autorest.WithHeader("x-ms-version", storageBlobServicePropertiesAPIVersion),
+		client.WithAuthorization())
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client storageBlobServicePropertiesClient) GetServiceProperties(ctx context.Context) (result storageBlobServiceProperties, err error) {
+	req, err := client.preparer(ctx, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "GetServiceProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "GetServiceProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "GetServiceProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// SetServiceProperties updates the Blob Service Properties - any elements which aren't specified are left unchanged
+func (client storageBlobServicePropertiesClient) SetServiceProperties(ctx context.Context, properties storageBlobServiceProperties) (result autorest.Response, err error) {
+	body, err := xml.Marshal(properties)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "SetServiceProperties", nil, "Failure marshalling request")
+		return
+	}
+
+	req, err := client.preparer(ctx, autorest.AsPut(), autorest.AsContentType("application/xml"), autorest.WithString(string(body)))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "SetServiceProperties", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "SetServiceProperties", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageBlobServicePropertiesClient", "SetServiceProperties", resp, "Failure responding to request")
+	}
+
+	return
+}
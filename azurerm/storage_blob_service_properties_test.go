@@ -0,0 +1,77 @@
+package azurerm
+
+import (
+	"encoding/xml"
+	"testing"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestStorageBlobServicePropertiesMarshal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    storageBlobServiceProperties
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    storageBlobServiceProperties{},
+			Expected: "<StorageServiceProperties></StorageServiceProperties>",
+		},
+		{
+			Name: "Static Website Disabled",
+			Input: storageBlobServiceProperties{
+				StaticWebsite: &storageStaticWebsite{
+					Enabled: false,
+				},
+			},
+			Expected: "<StorageServiceProperties><StaticWebsite><Enabled>false</Enabled></StaticWebsite></StorageServiceProperties>",
+		},
+		{
+			Name: "Complete",
+			Input: storageBlobServiceProperties{
+				Cors: &mainStorage.Cors{
+					CorsRule: []mainStorage.CorsRule{
+						{
+							AllowedOrigins:  "*",
+							AllowedMethods:  "GET,HEAD",
+							MaxAgeInSeconds: 3600,
+							ExposedHeaders:  "x-ms-meta-*",
+							AllowedHeaders:  "x-ms-meta-data*",
+						},
+					},
+				},
+				DefaultServiceVersion: utils.String("2018-03-28"),
+				DeleteRetentionPolicy: &storageDeleteRetentionPolicy{
+					Enabled: true,
+					Days:    utils.Int(7),
+				},
+				StaticWebsite: &storageStaticWebsite{
+					Enabled:              true,
+					IndexDocument:        utils.String("index.html"),
+					ErrorDocument404Path: utils.String("404.html"),
+				},
+			},
+			Expected: "<StorageServiceProperties>" +
+				"<Cors><CorsRule><AllowedOrigins>*</AllowedOrigins><AllowedMethods>GET,HEAD</AllowedMethods><MaxAgeInSeconds>3600</MaxAgeInSeconds><ExposedHeaders>x-ms-meta-*</ExposedHeaders><AllowedHeaders>x-ms-meta-data*</AllowedHeaders></CorsRule></Cors>" +
+				"<DefaultServiceVersion>2018-03-28</DefaultServiceVersion>" +
+				"<DeleteRetentionPolicy><Enabled>true</Enabled><Days>7</Days></DeleteRetentionPolicy>" +
+				"<StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument><ErrorDocument404Path>404.html</ErrorDocument404Path></StaticWebsite>" +
+				"</StorageServiceProperties>",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := xml.Marshal(v.Input)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if string(actual) != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, string(actual))
+		}
+	}
+}
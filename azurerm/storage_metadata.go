@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func storageMetaDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		ValidateFunc: validateStorageMetaData,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// validateStorageMetaData ensures the keys are valid C# identifiers, as required by the Storage API - and since
+// the keys are always returned in lower-case, that they're specified in lower-case to avoid a perpetual diff
+func validateStorageMetaData(v interface{}, k string) (warnings []string, errors []error) {
+	metaData := v.(map[string]interface{})
+
+	for key := range metaData {
+		if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(key) {
+			errors = append(errors, fmt.Errorf("%q can only contain lower-case letters, numbers and underscores and cannot start with a number: %q", k, key))
+		}
+	}
+
+	return warnings, errors
+}
+
+func expandStorageMetaData(input map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(input))
+
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+func flattenStorageMetaData(input map[string]string) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
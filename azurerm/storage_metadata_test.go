@@ -0,0 +1,51 @@
+package azurerm
+
+import "testing"
+
+func TestValidateStorageMetaData(t *testing.T) {
+	cases := []struct {
+		Input    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Input:    map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"hello":         "world",
+				"build_number":  "1234",
+				"_private":      "value",
+				"release2019q1": "true",
+			},
+			ErrCount: 0,
+		},
+		{
+			Input: map[string]interface{}{
+				"Hello": "world",
+			},
+			ErrCount: 1,
+		},
+		{
+			Input: map[string]interface{}{
+				"1hello": "world",
+			},
+			ErrCount: 1,
+		},
+		{
+			Input: map[string]interface{}{
+				"hello-world": "value",
+				"hello world": "value",
+			},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateStorageMetaData(tc.Input, "metadata")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %+v to trigger %d validation errors but got %d", tc.Input, tc.ErrCount, len(errors))
+		}
+	}
+}
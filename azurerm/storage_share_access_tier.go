@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the version of the Storage SDK used by the provider predates File Share Access Tiers (added in 2019-12-12), so until
+// that's upgraded this is a minimal data-plane client for them
+
+const storageShareAccessTierAPIVersion = "2019-12-12"
+
+type storageShareAccessTierClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+type storageShareAccessTier struct {
+	autorest.Response
+	AccessTier string
+	// AccessTierTransitionState is set whilst the Share is moving between tiers, e.g. `pending-from-hot`
+	AccessTierTransitionState string
+}
+
+func newStorageShareAccessTierClient(accountName, endpointSuffix string) storageShareAccessTierClient {
+	return storageShareAccessTierClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: fmt.Sprintf("https://%s.file.%s", accountName, endpointSuffix),
+	}
+}
+
+func (client storageShareAccessTierClient) preparer(ctx context.Context, shareName string, queryParameters map[string]interface{}, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"shareName": autorest.Encode("path", shareName),
+	}
+
+	queryParameters["restype"] = autorest.Encode("query", "share")
+
+	// the headers need to be set prior to the request being signed
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{shareName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-date", time.Now().UTC().Format(http.TimeFormat)),
+		autorest.WithHeader("x-ms-version", storageShareAccessTierAPIVersion),
+		client.WithAuthorization())
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetAccessTier retrieves the Access Tier of the Share from its properties
+func (client storageShareAccessTierClient) GetAccessTier(ctx context.Context, shareName string) (result storageShareAccessTier, err error) {
+	req, err := client.preparer(ctx, shareName, map[string]interface{}{}, autorest.AsHead())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "GetAccessTier", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "GetAccessTier", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "GetAccessTier", resp, "Failure responding to request")
+		return
+	}
+
+	result.AccessTier = resp.Header.Get("x-ms-access-tier")
+	result.AccessTierTransitionState = resp.Header.Get("x-ms-access-tier-transition-state")
+	return
+}
+
+// SetAccessTier sets the Access Tier of the Share, leaving its other properties (e.g. the Quota) unchanged
+func (client storageShareAccessTierClient) SetAccessTier(ctx context.Context, shareName, accessTier string) (result autorest.Response, err error) {
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "properties"),
+	}
+
+	req, err := client.preparer(ctx, shareName, queryParameters,
+		autorest.AsPut(),
+		autorest.WithHeader("x-ms-access-tier", accessTier))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "SetAccessTier", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "SetAccessTier", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareAccessTierClient", "SetAccessTier", resp, "Failure responding to request")
+	}
+
+	return
+}
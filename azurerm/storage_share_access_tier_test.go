@@ -0,0 +1,80 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testStorageShareAccessTierClient(t *testing.T, handler func(req *http.Request) http.Header) storageShareAccessTierClient {
+	client := newStorageShareAccessTierClient("acctestaccount", "core.windows.net")
+	client.Sender = autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if version := req.Header.Get("x-ms-version"); version != storageShareAccessTierAPIVersion {
+			t.Fatalf("Expected the API Version to be %q but got %q", storageShareAccessTierAPIVersion, version)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     handler(req),
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+			Request:    req,
+		}, nil
+	})
+	return client
+}
+
+func TestStorageShareAccessTierGetAccessTier(t *testing.T) {
+	client := testStorageShareAccessTierClient(t, func(req *http.Request) http.Header {
+		if req.Method != http.MethodHead {
+			t.Fatalf("Expected a HEAD request but got %q", req.Method)
+		}
+		if expected := "https://acctestaccount.file.core.windows.net/share1?restype=share"; req.URL.String() != expected {
+			t.Fatalf("Expected the URL to be %q but got %q", expected, req.URL.String())
+		}
+
+		headers := http.Header{}
+		headers.Set("x-ms-access-tier", "Cool")
+		headers.Set("x-ms-access-tier-transition-state", "pending-from-hot")
+		return headers
+	})
+
+	tier, err := client.GetAccessTier(context.Background(), "share1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if tier.AccessTier != "Cool" {
+		t.Fatalf("Expected the Access Tier to be %q but got %q", "Cool", tier.AccessTier)
+	}
+	if tier.AccessTierTransitionState != "pending-from-hot" {
+		t.Fatalf("Expected the Transition State to be %q but got %q", "pending-from-hot", tier.AccessTierTransitionState)
+	}
+}
+
+func TestStorageShareAccessTierSetAccessTier(t *testing.T) {
+	client := testStorageShareAccessTierClient(t, func(req *http.Request) http.Header {
+		if req.Method != http.MethodPut {
+			t.Fatalf("Expected a PUT request but got %q", req.Method)
+		}
+		if expected := "https://acctestaccount.file.core.windows.net/share1?comp=properties&restype=share"; req.URL.String() != expected {
+			t.Fatalf("Expected the URL to be %q but got %q", expected, req.URL.String())
+		}
+		if tier := req.Header.Get("x-ms-access-tier"); tier != "TransactionOptimized" {
+			t.Fatalf("Expected the Access Tier to be %q but got %q", "TransactionOptimized", tier)
+		}
+		// only the Access Tier should be changed
+		if quota := req.Header.Get("x-ms-share-quota"); quota != "" {
+			t.Fatalf("Expected the Quota not to be set but got %q", quota)
+		}
+
+		return http.Header{}
+	})
+
+	if _, err := client.SetAccessTier(context.Background(), "share1", "TransactionOptimized"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+}
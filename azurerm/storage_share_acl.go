@@ -0,0 +1,120 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the version of the Storage SDK used by the provider doesn't support getting or setting the Stored Access Policies
+// of a File Share, so until that's upgraded this is a minimal data-plane client for them
+
+const storageShareACLAPIVersion = "2018-03-28"
+
+type storageShareACLClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+type storageShareACL struct {
+	autorest.Response `xml:"-"`
+	XMLName           xml.Name                       `xml:"SignedIdentifiers"`
+	SignedIdentifiers []mainStorage.SignedIdentifier `xml:"SignedIdentifier"`
+}
+
+func newStorageShareACLClient(accountName, endpointSuffix string) storageShareACLClient {
+	return storageShareACLClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: fmt.Sprintf("https://%s.file.%s", accountName, endpointSuffix),
+	}
+}
+
+func (client storageShareACLClient) preparer(ctx context.Context, shareName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"shareName": autorest.Encode("path", shareName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp":    autorest.Encode("query", "acl"),
+		"restype": autorest.Encode("query", "share"),
+	}
+
+	// the headers need to be set prior to the request being signed
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{shareName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-date", time.Now().UTC().Format(http.TimeFormat)),
+		autorest.WithHeader("x-ms-version", storageShareACLAPIVersion),
+		client.WithAuthorization())
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client storageShareACLClient) GetACL(ctx context.Context, shareName string) (result storageShareACL, err error) {
+	req, err := client.preparer(ctx, shareName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "GetACL", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "GetACL", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "GetACL", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// SetACL replaces the Stored Access Policies of the Share - an empty list removes all of them
+func (client storageShareACLClient) SetACL(ctx context.Context, shareName string, signedIdentifiers []mainStorage.SignedIdentifier) (result autorest.Response, err error) {
+	body, err := xml.Marshal(storageShareACL{SignedIdentifiers: signedIdentifiers})
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "SetACL", nil, "Failure marshalling request")
+		return
+	}
+
+	req, err := client.preparer(ctx, shareName, autorest.AsPut(), autorest.AsContentType("application/xml"), autorest.WithString(string(body)))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "SetACL", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "SetACL", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.storageShareACLClient", "SetACL", resp, "Failure responding to request")
+	}
+
+	return
+}
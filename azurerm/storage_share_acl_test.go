@@ -0,0 +1,74 @@
+package azurerm
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+)
+
+func TestStorageShareACLMarshal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []mainStorage.SignedIdentifier
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    []mainStorage.SignedIdentifier{},
+			Expected: "<SignedIdentifiers></SignedIdentifiers>",
+		},
+		{
+			Name: "Single",
+			Input: []mainStorage.SignedIdentifier{
+				{
+					ID: "readonly",
+					AccessPolicy: mainStorage.AccessPolicyDetailsXML{
+						StartTime:  time.Date(2019, 7, 2, 9, 38, 21, 0, time.UTC),
+						ExpiryTime: time.Date(2019, 7, 2, 10, 38, 21, 0, time.UTC),
+						Permission: "rl",
+					},
+				},
+			},
+			Expected: "<SignedIdentifiers><SignedIdentifier><Id>readonly</Id><AccessPolicy>" +
+				"<Start>2019-07-02T09:38:21Z</Start><Expiry>2019-07-02T10:38:21Z</Expiry><Permission>rl</Permission>" +
+				"</AccessPolicy></SignedIdentifier></SignedIdentifiers>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual, err := xml.Marshal(storageShareACL{SignedIdentifiers: tc.Input})
+		if err != nil {
+			t.Fatalf("Error marshalling: %+v", err)
+		}
+
+		if string(actual) != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, string(actual))
+		}
+	}
+}
+
+func TestStorageShareACLUnmarshal(t *testing.T) {
+	// the Storage service returns times with seven fractional digits
+	input := `<?xml version="1.0" encoding="utf-8"?><SignedIdentifiers><SignedIdentifier><Id>readonly</Id><AccessPolicy>` +
+		`<Start>2019-07-02T09:38:21.0000000Z</Start><Expiry>2019-07-02T10:38:21.0000000Z</Expiry><Permission>rl</Permission>` +
+		`</AccessPolicy></SignedIdentifier></SignedIdentifiers>`
+
+	var actual storageShareACL
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("Error unmarshalling: %+v", err)
+	}
+
+	if len(actual.SignedIdentifiers) != 1 {
+		t.Fatalf("Expected 1 Signed Identifier but got %d", len(actual.SignedIdentifiers))
+	}
+
+	flattened := flattenStorageACLs(actual.SignedIdentifiers)
+	policy := flattened[0].(map[string]interface{})["access_policy"].([]interface{})[0].(map[string]interface{})
+	if policy["start"] != "2019-07-02T09:38:21Z" || policy["expiry"] != "2019-07-02T10:38:21Z" {
+		t.Fatalf("Expected the times to be normalised but got %q and %q", policy["start"], policy["expiry"])
+	}
+}
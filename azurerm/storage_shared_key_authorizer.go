@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// storageSharedKeyAuthorizer signs Storage data-plane requests made through autorest using the Account Key, for the
+// (few) operations which the Storage SDK used by the provider doesn't support.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+type storageSharedKeyAuthorizer struct {
+	accountName string
+	accountKey  []byte
+}
+
+func newStorageSharedKeyAuthorizer(accountName, accountKey string) (*storageSharedKeyAuthorizer, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding the Account Key for Storage Account %q: %+v", accountName, err)
+	}
+
+	return &storageSharedKeyAuthorizer{
+		accountName: accountName,
+		accountKey:  key,
+	}, nil
+}
+
+func (a storageSharedKeyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			signature, err := a.sign(r)
+			if err != nil {
+				return r, err
+			}
+
+			r.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", a.accountName, signature))
+			return r, nil
+		})
+	}
+}
+
+func (a storageSharedKeyAuthorizer) sign(r *http.Request) (string, error) {
+	canonicalizedResource, err := a.canonicalizedResource(r.URL)
+	if err != nil {
+		return "", err
+	}
+
+	contentLength := ""
+	if r.ContentLength > 0 {
+		contentLength = strconv.FormatInt(r.ContentLength, 10)
+	}
+
+	stringToSign := strings.Join([]string{
+		r.Method,
+		r.Header.Get("Content-Encoding"),
+		r.Header.Get("Content-Language"),
+		contentLength,
+		r.Header.Get("Content-MD5"),
+		r.Header.Get("Content-Type"),
+		r.Header.Get("Date"),
+		r.Header.Get("If-Modified-Since"),
+		r.Header.Get("If-Match"),
+		r.Header.Get("If-None-Match"),
+		r.Header.Get("If-Unmodified-Since"),
+		r.Header.Get("Range"),
+		storageCanonicalizedHeaders(r.Header) + canonicalizedResource,
+	}, "\n")
+
+	h := hmac.New(sha256.New, a.accountKey)
+	if _, err := h.Write([]byte(stringToSign)); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func (a storageSharedKeyAuthorizer) canonicalizedResource(u *url.URL) (string, error) {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	resource := fmt.Sprintf("/%s%s", a.accountName, path)
+
+	params, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing the query string %q: %+v", u.RawQuery, err)
+	}
+
+	names := make([]string, 0, len(params))
+	values := make(map[string][]string)
+	for name, v := range params {
+		lower := strings.ToLower(name)
+		if _, exists := values[lower]; !exists {
+			names = append(names, lower)
+		}
+		values[lower] = append(values[lower], v...)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := values[name]
+		sort.Strings(v)
+		resource += fmt.Sprintf("\n%s:%s", name, strings.Join(v, ","))
+	}
+
+	return resource, nil
+}
+
+func storageCanonicalizedHeaders(headers http.Header) string {
+	names := make([]string, 0)
+	values := make(map[string]string)
+	for name, v := range headers {
+		lower := strings.ToLower(strings.TrimSpace(name))
+		if !strings.HasPrefix(lower, "x-ms-") {
+			continue
+		}
+
+		names = append(names, lower)
+		values[lower] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	sort.Strings(names)
+
+	canonicalized := ""
+	for _, name := range names {
+		canonicalized += fmt.Sprintf("%s:%s\n", name, values[name])
+	}
+
+	return canonicalized
+}
@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testStorageServicePropertiesSender struct {
+	request *http.Request
+}
+
+func (s *testStorageServicePropertiesSender) Send(c *mainStorage.Client, req *http.Request) (*http.Response, error) {
+	s.request = req
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader("<StorageServiceProperties></StorageServiceProperties>")),
+	}, nil
+}
+
+func TestStorageSharedKeyAuthorizer(t *testing.T) {
+	accountName := "example"
+	accountKey := base64.StdEncoding.EncodeToString([]byte("not-a-real-account-key"))
+
+	// the Storage SDK signs requests in the same way, so we can compare the signature for an identical request
+	client, err := mainStorage.NewClient(accountName, accountKey, mainStorage.DefaultBaseURL, mainStorage.DefaultAPIVersion, true)
+	if err != nil {
+		t.Fatalf("Error building Storage Client: %+v", err)
+	}
+	recorder := &testStorageServicePropertiesSender{}
+	client.Sender = recorder
+
+	blobService := client.GetBlobService()
+	if _, err := blobService.GetServiceProperties(); err != nil {
+		t.Fatalf("Error retrieving Service Properties: %+v", err)
+	}
+
+	expected := recorder.request.Header.Get("Authorization")
+	if expected == "" {
+		t.Fatalf("Expected the Storage SDK to sign the request but no Authorization header was set")
+	}
+
+	req, err := http.NewRequest(recorder.request.Method, recorder.request.URL.String(), nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+	for name, values := range recorder.request.Header {
+		if name != "Authorization" {
+			req.Header[name] = values
+		}
+	}
+
+	authorizer, err := newStorageSharedKeyAuthorizer(accountName, accountKey)
+	if err != nil {
+		t.Fatalf("Error building Authorizer: %+v", err)
+	}
+
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error signing request: %+v", err)
+	}
+
+	if actual := req.Header.Get("Authorization"); actual != expected {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", expected, actual)
+	}
+
+	if _, err := newStorageSharedKeyAuthorizer(accountName, "not base64!"); err == nil {
+		t.Fatalf("Expected an error for an Account Key which isn't base64 encoded but didn't get one")
+	}
+}
@@ -4,6 +4,10 @@ func Bool(input bool) *bool {
 	return &input
 }
 
+func Int(input int) *int {
+	return &input
+}
+
 func Int32(input int32) *int32 {
 	return &input
 }
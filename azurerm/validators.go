@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func validateRFC3339Date(v interface{}, k string) (warnings []string, errors []error) {
@@ -205,3 +206,11 @@ func validateFilePath() schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+func validateNetworkAddressPrefixOrServiceTag(i interface{}, k string) ([]string, []error) {
+	if !validateNetworkServiceTags {
+		return nil, nil
+	}
+
+	return validate.NetworkAddressPrefixOrServiceTag(i, k)
+}
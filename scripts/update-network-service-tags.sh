@@ -0,0 +1,34 @@
+#!/bin/bash
+
+# This script regenerates the list of Service Tag names which is compiled into
+# the provider, and used to validate the names of Service Tags within Network
+# Security & Firewall Rules when ARM_PROVIDER_VALIDATE_SERVICE_TAGS is set.
+#
+# It requires the Azure CLI (logged in) and jq, and is run through `go generate`
+# in azurerm/helpers/validate - although it can also be run directly.
+
+set -e
+
+cd "$(dirname "$0")/.."
+
+OUTPUT="azurerm/helpers/validate/network_service_tags_generated.go"
+LOCATION="${1:-westeurope}"
+
+CATALOG=$(az network list-service-tags --location "$LOCATION" --output json)
+CHANGE_NUMBER=$(echo "$CATALOG" | jq -r '.changeNumber')
+CLOUD=$(echo "$CATALOG" | jq -r '.cloud')
+
+{
+  echo "// Code generated by scripts/update-network-service-tags.sh; DO NOT EDIT."
+  echo
+  echo "package validate"
+  echo
+  echo "// networkServiceTags are the names of the Service Tags in the $CLOUD cloud, as of change number $CHANGE_NUMBER"
+  echo "var networkServiceTags = []string{"
+  echo "$CATALOG" | jq -r '[.values[].name] | unique | .[] | "\t\"" + . + "\","'
+  echo "}"
+} > "$OUTPUT.tmp"
+
+gofmt "$OUTPUT.tmp" > "$OUTPUT"
+rm "$OUTPUT.tmp"
+echo "Updated $OUTPUT from the Service Tags in $LOCATION"